@@ -0,0 +1,246 @@
+package tween
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the source of time for an Engine. Replacing the Clock of an Engine
+// allows tweens to be driven deterministically, e.g. in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// NewTicker returns a Ticker that ticks every period d.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers the ticks of a Clock at regular intervals.
+type Ticker interface {
+	// C returns the channel on which the ticks are delivered.
+	C() <-chan time.Time
+	// Stop turns off the ticker. No more ticks are delivered after Stop.
+	Stop()
+}
+
+// SystemClock is the Clock backed by the time package. It is used by any
+// Engine that doesn't have a Clock.
+var SystemClock Clock = systemClock{}
+
+// systemClock implements Clock with time.Now and time.NewTicker.
+type systemClock struct{}
+
+// Now returns the current local time.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTicker returns a time.Ticker that ticks every period d.
+func (systemClock) NewTicker(d time.Duration) Ticker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+// systemTicker adapts time.Ticker to the Ticker interface.
+type systemTicker struct {
+	*time.Ticker
+}
+
+// C returns the ticker channel.
+func (t systemTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
+
+// NewVSyncClock creates a Clock whose tickers tick on the signals received
+// from vsync, e.g. the vertical sync of a display, rather than every period. A
+// signal is received by a single ticker, so a vsync Clock drives a single
+// Engine or Manager. The times sent on vsync must be on the time.Now clock.
+func NewVSyncClock(vsync <-chan time.Time) Clock {
+	return vsyncClock{vsync}
+}
+
+// vsyncClock implements Clock with time.Now and the ticks of a vsync signal.
+type vsyncClock struct {
+	vsync <-chan time.Time
+}
+
+// Now returns the current local time.
+func (vsyncClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTicker returns a Ticker that ticks on the vsync signal, ignoring d.
+func (c vsyncClock) NewTicker(d time.Duration) Ticker {
+	return vsyncTicker{c.vsync}
+}
+
+// vsyncTicker is a Ticker of a vsyncClock.
+type vsyncTicker struct {
+	vsync <-chan time.Time
+}
+
+// C returns the vsync channel.
+func (t vsyncTicker) C() <-chan time.Time {
+	return t.vsync
+}
+
+// Stop does nothing, the vsync signal is owned by the caller.
+func (vsyncTicker) Stop() {}
+
+// NewUnthrottledClock creates a Clock that runs as fast as its ticks are
+// received, starting at the time now. Every tick moves the clock on by the
+// period of its ticker as soon as the previous tick has been received, so a
+// tween sends its frames back to back, as fast as its Updater accepts them,
+// with exact frame times. This suits exporting frames to a slow consumer, e.g.
+// a video encoder, without blocking on Render. Every ticker moves the same
+// clock, and a paused tween keeps receiving ticks, so an unthrottled Clock
+// drives a single Engine or Manager that is never paused.
+func NewUnthrottledClock(now time.Time) Clock {
+	return &unthrottledClock{now: now}
+}
+
+// unthrottledClock implements Clock with ticks that come as fast as they are
+// received.
+type unthrottledClock struct {
+	mu  sync.Mutex // mu guards the time
+	now time.Time  // now is the time of the latest tick received
+}
+
+// Now returns the time of the latest tick received.
+func (c *unthrottledClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTicker returns a Ticker that ticks every period d of the clock time, as
+// soon as the previous tick has been received.
+func (c *unthrottledClock) NewTicker(d time.Duration) Ticker {
+	t := &unthrottledTicker{c: make(chan time.Time), stop: make(chan struct{})}
+	go func() {
+		for {
+			c.mu.Lock()
+			at := c.now.Add(d)
+			c.mu.Unlock()
+			select {
+			case t.c <- at:
+				c.mu.Lock()
+				c.now = at
+				c.mu.Unlock()
+			case <-t.stop:
+				return
+			}
+		}
+	}()
+	return t
+}
+
+// unthrottledTicker is a Ticker of an unthrottledClock.
+type unthrottledTicker struct {
+	c    chan time.Time // c delivers the ticks
+	stop chan struct{}  // stop is closed when the ticker is stopped
+	once sync.Once      // once guards closing stop
+}
+
+// C returns the channel on which the ticks are delivered.
+func (t *unthrottledTicker) C() <-chan time.Time {
+	return t.c
+}
+
+// Stop turns off the ticker.
+func (t *unthrottledTicker) Stop() {
+	t.once.Do(func() { close(t.stop) })
+}
+
+// NewFakeClock creates a FakeClock set to the time now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// FakeClock is a Clock that only moves when it is advanced, which allows
+// tests to control time and fire ticks deterministically.
+type FakeClock struct {
+	mu      sync.Mutex    // mu guards the time and tickers
+	now     time.Time     // now is the current time of the clock
+	tickers []*fakeTicker // tickers are the running tickers of the clock
+}
+
+// Now returns the current time of the clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTicker returns a Ticker that ticks every period d of the clock time.
+func (c *FakeClock) NewTicker(d time.Duration) Ticker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &fakeTicker{
+		clock:  c,
+		c:      make(chan time.Time),
+		stop:   make(chan struct{}),
+		period: d,
+		next:   c.now.Add(d),
+	}
+	c.tickers = append(c.tickers, t)
+	return t
+}
+
+// Advance moves the clock forward by d. Every tick that falls due on the way
+// is delivered in order, with the clock set to the time of the tick. Unlike
+// time.Ticker no ticks are dropped: Advance blocks until each tick has been
+// received or its ticker is stopped.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	end := c.now.Add(d)
+	c.mu.Unlock()
+	for {
+		c.mu.Lock()
+		var next *fakeTicker
+		for _, t := range c.tickers {
+			if !t.next.After(end) && (next == nil || t.next.Before(next.next)) {
+				next = t
+			}
+		}
+		if next == nil {
+			c.now = end
+			c.mu.Unlock()
+			return
+		}
+		at := next.next
+		c.now = at
+		next.next = at.Add(next.period)
+		c.mu.Unlock()
+
+		select {
+		case next.c <- at:
+		case <-next.stop:
+		}
+	}
+}
+
+// fakeTicker is a Ticker of a FakeClock.
+type fakeTicker struct {
+	clock  *FakeClock
+	c      chan time.Time // c delivers the ticks
+	stop   chan struct{}  // stop is closed when the ticker is stopped
+	period time.Duration  // period is the time between ticks
+	next   time.Time      // next is the time of the next tick
+}
+
+// C returns the channel on which the ticks are delivered.
+func (t *fakeTicker) C() <-chan time.Time {
+	return t.c
+}
+
+// Stop turns off the ticker.
+func (t *fakeTicker) Stop() {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	for i, other := range t.clock.tickers {
+		if other == t {
+			t.clock.tickers = append(t.clock.tickers[:i], t.clock.tickers[i+1:]...)
+			close(t.stop)
+			return
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package tween_test
+
+import (
+	"time"
+
+	. "github.com/draoncc/tween"
+	"github.com/draoncc/tween/easing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Clock", func() {
+	Describe("FakeClock", func() {
+		It("should only move when advanced", func() {
+			start := time.Now()
+			clock := NewFakeClock(start)
+			Ω(clock.Now()).Should(Equal(start))
+			clock.Advance(time.Minute)
+			Ω(clock.Now()).Should(Equal(start.Add(time.Minute)))
+		})
+		It("should deliver every tick that falls due", func(done Done) {
+			start := time.Now()
+			clock := NewFakeClock(start)
+			ticker := clock.NewTicker(10 * time.Millisecond)
+			ticks := make(chan []time.Time)
+			go func() {
+				received := []time.Time{}
+				for i := 0; i < 3; i++ {
+					received = append(received, <-ticker.C())
+				}
+				ticks <- received
+			}()
+			clock.Advance(35 * time.Millisecond)
+			Ω(<-ticks).Should(Equal([]time.Time{
+				start.Add(10 * time.Millisecond),
+				start.Add(20 * time.Millisecond),
+				start.Add(30 * time.Millisecond),
+			}))
+			Ω(clock.Now()).Should(Equal(start.Add(35 * time.Millisecond)))
+			close(done)
+		}, 1)
+		It("should not deliver ticks once stopped", func(done Done) {
+			clock := NewFakeClock(time.Now())
+			ticker := clock.NewTicker(time.Millisecond)
+			ticker.Stop()
+			clock.Advance(time.Second)
+			Ω(ticker.C()).ShouldNot(Receive())
+			close(done)
+		}, 1)
+	})
+	Describe("VSyncClock", func() {
+		It("should send a frame on each vsync signal", func(done Done) {
+			vsync := make(chan time.Time)
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Clock = NewVSyncClock(vsync)
+			engine.Start()
+			Eventually(recorder.Count).Should(Equal(1))
+			now := time.Now()
+			for i := 1; i <= 3; i++ {
+				vsync <- now.Add(time.Duration(i) * 20 * time.Millisecond)
+			}
+			Eventually(recorder.Count).Should(Equal(4))
+			engine.Stop()
+			Eventually(recorder.Done).Should(Receive())
+			close(done)
+		}, 1)
+	})
+	Describe("UnthrottledClock", func() {
+		It("should send every frame as fast as they are received", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			engine := NewEngine(time.Hour, easing.Linear, recorder)
+			engine.Framerate = FPS(1)
+			engine.Clock = NewUnthrottledClock(time.Now())
+			engine.Start()
+			<-d
+			Ω(recorder.Frames).Should(HaveLen(3601))
+			for i, frame := range recorder.Frames {
+				Ω(frame.Index).Should(Equal(i))
+				Ω(frame.Elapsed).Should(Equal(time.Duration(i) * time.Second))
+			}
+			close(done)
+		}, 5)
+	})
+})
@@ -86,6 +86,10 @@ var _ = Describe("Basic Curves", func() {
 				FuncInfo{"BounceIn", BounceIn},
 				FuncInfo{"BounceOut", BounceOut},
 				FuncInfo{"BounceInOut", BounceInOut},
+				FuncInfo{"Ease", Ease},
+				FuncInfo{"EaseIn", EaseIn},
+				FuncInfo{"EaseOut", EaseOut},
+				FuncInfo{"EaseInOut", EaseInOut},
 			}
 			html, err := os.Create("easing.html")
 			Ω(err).Should(BeNil())
@@ -0,0 +1,117 @@
+package easing
+
+import (
+	"math"
+
+	"github.com/draoncc/tween"
+)
+
+// The CSS named timing functions.
+var (
+	ease      = CubicBezier(.25, .1, .25, 1)
+	easeIn    = CubicBezier(.42, 0, 1, 1)
+	easeOut   = CubicBezier(0, 0, .58, 1)
+	easeInOut = CubicBezier(.42, 0, .58, 1)
+)
+
+// Ease is the CSS ease transition, cubic-bezier(0.25, 0.1, 0.25, 1).
+func Ease(completed float64) float64 {
+	return ease(completed)
+}
+
+// EaseIn is the CSS ease-in transition, cubic-bezier(0.42, 0, 1, 1).
+func EaseIn(completed float64) float64 {
+	return easeIn(completed)
+}
+
+// EaseOut is the CSS ease-out transition, cubic-bezier(0, 0, 0.58, 1).
+func EaseOut(completed float64) float64 {
+	return easeOut(completed)
+}
+
+// EaseInOut is the CSS ease-in-out transition, cubic-bezier(0.42, 0, 0.58, 1).
+func EaseInOut(completed float64) float64 {
+	return easeInOut(completed)
+}
+
+// bezierEpsilon is the precision x is solved to, as in browsers.
+const bezierEpsilon = 1e-7
+
+// CubicBezier creates a transition from the CSS cubic-bezier(x1, y1, x2, y2)
+// timing function, i.e. the cubic Bézier curve from (0, 0) to (1, 1) with the
+// control points (x1, y1) and (x2, y2). The y values may go outside 0 - 1 to
+// overshoot; the x values are clamped to 0 - 1 as CSS requires.
+//
+// Like browsers, the curve is solved for the completed time x with a few
+// Newton-Raphson iterations, falling back to bisection where they don't
+// converge.
+func CubicBezier(x1, y1, x2, y2 float64) tween.TransitionFunc {
+	x1 = math.Max(0, math.Min(1, x1))
+	x2 = math.Max(0, math.Min(1, x2))
+	x := newPolynomial(x1, x2)
+	y := newPolynomial(y1, y2)
+	return func(completed float64) float64 {
+		if completed <= 0 || completed >= 1 {
+			return completed
+		}
+		return y.sample(x.solve(completed))
+	}
+}
+
+// polynomial is one coordinate of a cubic Bézier curve from 0 to 1, in
+// polynomial form a t³ + b t² + c t.
+type polynomial struct {
+	a, b, c float64
+}
+
+// newPolynomial creates the polynomial of the coordinate with the control
+// points p1 and p2.
+func newPolynomial(p1, p2 float64) polynomial {
+	c := 3 * p1
+	b := 3*(p2-p1) - c
+	return polynomial{a: 1 - c - b, b: b, c: c}
+}
+
+// sample returns the coordinate at t.
+func (p polynomial) sample(t float64) float64 {
+	return ((p.a*t+p.b)*t + p.c) * t
+}
+
+// derivative returns the derivative of the coordinate at t.
+func (p polynomial) derivative(t float64) float64 {
+	return (3*p.a*t+2*p.b)*t + p.c
+}
+
+// solve returns the t at which the coordinate is v, for v between 0 and 1.
+func (p polynomial) solve(v float64) float64 {
+	// Newton-Raphson converges quickly unless the slope is close to flat
+	t := v
+	for i := 0; i < 8; i++ {
+		err := p.sample(t) - v
+		if math.Abs(err) < bezierEpsilon {
+			return t
+		}
+		d := p.derivative(t)
+		if math.Abs(d) < 1e-6 {
+			break
+		}
+		t -= err / d
+	}
+
+	// bisection always converges, the coordinate is monotonic in 0 - 1
+	lo, hi := 0., 1.
+	t = v
+	for hi-lo > bezierEpsilon {
+		sample := p.sample(t)
+		if math.Abs(sample-v) < bezierEpsilon {
+			return t
+		}
+		if sample < v {
+			lo = t
+		} else {
+			hi = t
+		}
+		t = (lo + hi) / 2
+	}
+	return t
+}
@@ -0,0 +1,44 @@
+package easing_test
+
+import (
+	"github.com/draoncc/tween"
+	. "github.com/draoncc/tween/easing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// expectCurve compares a transition with the values a browser calculates at
+// 0.1, 0.25, 0.5, 0.75 and 0.9.
+func expectCurve(f tween.TransitionFunc, values ...float64) {
+	for i, completed := range []float64{.1, .25, .5, .75, .9} {
+		Ω(f(completed)).Should(BeNumerically("~", values[i], 1e-6), "at %v", completed)
+	}
+	Ω(f(0)).Should(Equal(0.))
+	Ω(f(1)).Should(Equal(1.))
+}
+
+var _ = Describe("Cubic Bezier", func() {
+	It("should match the CSS named timing functions", func() {
+		expectCurve(Ease, 0.0947963, 0.4085106, 0.8024034, 0.9604590, 0.9943165)
+		expectCurve(EaseIn, 0.0170266, 0.0934647, 0.3153568, 0.6218619, 0.8394278)
+		expectCurve(EaseOut, 0.1605722, 0.3781381, 0.6846432, 0.9065353, 0.9829734)
+		expectCurve(EaseInOut, 0.0197225, 0.1291619, 0.5, 0.8708381, 0.9802775)
+	})
+	It("should overshoot with y values outside 0 - 1", func() {
+		expectCurve(CubicBezier(.68, -.55, .265, 1.55), -0.0662915, -0.0828071, 0.6066799, 1.0891658, 1.0623732)
+	})
+	It("should be linear with control points on the diagonal", func() {
+		linear := CubicBezier(.3, .3, .7, .7)
+		for completed := 0.; completed <= 1; completed += .05 {
+			Ω(linear(completed)).Should(BeNumerically("~", completed, 1e-6))
+		}
+	})
+	It("should solve curves with flat slopes", func() {
+		// the x slope is zero at t = 0.5, where Newton-Raphson can't go on
+		steep := CubicBezier(1, 0, 0, 1)
+		Ω(steep(.5)).Should(BeNumerically("~", .5, 1e-6))
+		Ω(steep(.49)).Should(BeNumerically("<", .5))
+		Ω(steep(.51)).Should(BeNumerically(">", .5))
+	})
+})
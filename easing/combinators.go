@@ -0,0 +1,90 @@
+package easing
+
+import (
+	"math"
+
+	"github.com/draoncc/tween"
+)
+
+// Out creates the ease out transition of the ease in transition f, i.e. f
+// turned upside down and played backwards.
+func Out(f tween.TransitionFunc) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		return 1 - f(1-completed)
+	}
+}
+
+// InOut creates the ease in and out transition of the ease in transition f,
+// easing in during the first half and out during the second.
+func InOut(f tween.TransitionFunc) tween.TransitionFunc {
+	return Chain(f, Out(f), .5)
+}
+
+// OutIn creates the ease out and in transition of the ease in transition f,
+// easing out during the first half and in during the second.
+func OutIn(f tween.TransitionFunc) tween.TransitionFunc {
+	return Chain(Out(f), f, .5)
+}
+
+// Reverse plays the transition f backwards, from its end to its start.
+func Reverse(f tween.TransitionFunc) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		return f(1 - completed)
+	}
+}
+
+// Mirror plays the transition f to its end during the first half and back to
+// its start during the second, like a Yoyo within a single transition.
+func Mirror(f tween.TransitionFunc) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		if completed < .5 {
+			return f(completed * 2)
+		}
+		return f(2 - completed*2)
+	}
+}
+
+// Chain plays the transition f and then the transition g, switching at the
+// completed percentage at. f moves from the start to at and g from at to the
+// end, so Chain(f, Out(f), 0.5) is InOut(f).
+func Chain(f, g tween.TransitionFunc, at float64) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		if completed < at {
+			return f(completed/at) * at
+		}
+		if at >= 1 {
+			return f(1)
+		}
+		return at + g((completed-at)/(1-at))*(1-at)
+	}
+}
+
+// Blend mixes the transitions f and g, from all f at a weight of 0 to all g
+// at a weight of 1.
+func Blend(f, g tween.TransitionFunc, weight float64) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		return f(completed)*(1-weight) + g(completed)*weight
+	}
+}
+
+// Clamp keeps the transition f between its start and end values, cutting off
+// any overshoot.
+func Clamp(f tween.TransitionFunc) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		return math.Max(0, math.Min(f(completed), 1))
+	}
+}
+
+// Remap plays the transition f between the completed percentages from and
+// to, holding its start value before and its end value after.
+func Remap(f tween.TransitionFunc, from, to float64) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		switch {
+		case completed <= from:
+			return f(0)
+		case completed >= to:
+			return f(1)
+		}
+		return f((completed - from) / (to - from))
+	}
+}
@@ -0,0 +1,63 @@
+package easing_test
+
+import (
+	. "github.com/draoncc/tween/easing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Combinators", func() {
+	It("should build Out and InOut like the generated curves", func() {
+		expectSame(Out(QuadIn), QuadOut)
+		expectSame(InOut(QuadIn), QuadInOut)
+		expectSame(Out(SineIn), SineOut)
+		expectSame(InOut(CubicIn), CubicInOut)
+	})
+	It("should ease out then in", func() {
+		expectSame(OutIn(QuadIn), func(completed float64) float64 {
+			if completed < .5 {
+				return QuadOut(2*completed) / 2
+			}
+			return .5 + QuadIn(2*completed-1)/2
+		})
+	})
+	It("should reverse and mirror", func() {
+		expectSame(Reverse(QuadIn), func(completed float64) float64 {
+			return QuadIn(1 - completed)
+		})
+		Ω(Mirror(Linear)(.25)).Should(Equal(.5))
+		Ω(Mirror(Linear)(.5)).Should(Equal(1.))
+		Ω(Mirror(Linear)(.75)).Should(Equal(.5))
+		Ω(Mirror(Linear)(1)).Should(Equal(0.))
+	})
+	It("should chain two transitions", func() {
+		f := Chain(Linear, Steps(2, JumpEnd), .4)
+		Ω(f(.2)).Should(BeNumerically("~", .2, 1e-12))
+		Ω(f(.5)).Should(BeNumerically("~", .4, 1e-12))
+		Ω(f(.8)).Should(BeNumerically("~", .7, 1e-12))
+		Ω(f(1)).Should(Equal(1.))
+		Ω(Chain(Linear, QuadIn, 1)(1)).Should(Equal(1.))
+	})
+	It("should blend two transitions", func() {
+		expectSame(Blend(QuadIn, CubicIn, 0), QuadIn)
+		expectSame(Blend(QuadIn, CubicIn, 1), CubicIn)
+		Ω(Blend(Linear, QuadIn, .5)(.5)).Should(Equal(.375))
+	})
+	It("should clamp overshoot", func() {
+		f := Clamp(BackIn)
+		for completed := 0.; completed <= 1; completed += .01 {
+			Ω(f(completed)).Should(BeNumerically(">=", 0))
+			Ω(f(completed)).Should(BeNumerically("<=", 1))
+		}
+		Ω(f(1)).Should(Equal(1.))
+	})
+	It("should remap a transition to part of the time", func() {
+		f := Remap(Linear, .2, .7)
+		Ω(f(0)).Should(Equal(0.))
+		Ω(f(.2)).Should(Equal(0.))
+		Ω(f(.45)).Should(BeNumerically("~", .5, 1e-12))
+		Ω(f(.7)).Should(Equal(1.))
+		Ω(f(.9)).Should(Equal(1.))
+	})
+})
@@ -175,10 +175,7 @@ func LogInOut(completed float64) float64 {
 // ElasticIn eases in a Elastic transition.
 // See http://jqueryui.com/easing/ for curve in action.
 func ElasticIn(completed float64) float64 {
-	if completed == 0 || completed == 1 {
-		return completed
-	}
-	return -math.Pow(2, 8*(completed-1)) * math.Sin(((completed-1)*80-7.5)*math.Pi/15)
+	return elasticIn(completed)
 }
 
 // ElasticOut eases out a Elastic transition.
@@ -199,7 +196,7 @@ func ElasticInOut(completed float64) float64 {
 // BackIn eases in a Back transition.
 // See http://jqueryui.com/easing/ for curve in action.
 func BackIn(completed float64) float64 {
-	return completed * completed * (3*completed - 2)
+	return backIn(completed)
 }
 
 // BackOut eases out a Back transition.
@@ -220,13 +217,7 @@ func BackInOut(completed float64) float64 {
 // BounceIn eases in a Bounce transition.
 // See http://jqueryui.com/easing/ for curve in action.
 func BounceIn(completed float64) float64 {
-
-	bounce := float64(3)
-	var pow2 float64
-	for pow2 = math.Pow(2, bounce); completed < ((pow2 - 1) / 11); pow2 = math.Pow(2, bounce) {
-		bounce--
-	}
-	return 1/math.Pow(4, 3-bounce) - 7.5625*math.Pow((pow2*3-2)/22-completed, 2)
+	return bounceIn(completed)
 }
 
 // BounceOut eases out a Bounce transition.
@@ -72,23 +72,14 @@ func main() {
 	// Logarithmic curve
 	add("Log", "return 1 - math.Log((1 - completed) * (math.E - 1) + 1)")
 
-	// Elastic (rubber band) curve
-	add("Elastic", `if completed == 0 || completed == 1 {
-            return completed
-        }
-        return -math.Pow( 2, 8 * ( completed - 1 ) ) * math.Sin( ( ( completed - 1 ) * 80 - 7.5 ) * math.Pi / 15 )`)
-
-	// Back (starts in reverse) curve
-	add("Back", "return completed * completed * ( 3 * completed - 2 )")
-
-	// Bounce (like a rubber ball) curve
-	add("Bounce", `
-        bounce := float64(3)
-        var pow2 float64
-        for pow2 = math.Pow( 2, bounce ); completed < (( pow2 - 1 ) / 11); pow2 = math.Pow( 2, bounce ) {
-            bounce--
-        }
-        return 1 / math.Pow( 4, 3 - bounce ) - 7.5625 * math.Pow( ( pow2 * 3 - 2 ) / 22 - completed, 2 )`)
+	// Elastic (rubber band) curve, see Elastic in parametric.go
+	add("Elastic", "return elasticIn(completed)")
+
+	// Back (starts in reverse) curve, see Back in parametric.go
+	add("Back", "return backIn(completed)")
+
+	// Bounce (like a rubber ball) curve, see Bounce in parametric.go
+	add("Bounce", "return bounceIn(completed)")
 
 	// Set up ease function templates
 	ease := []*template.Template{}
@@ -0,0 +1,87 @@
+package easing
+
+import (
+	"math"
+
+	"github.com/draoncc/tween"
+)
+
+// The In curves of ElasticIn, BackIn and BounceIn, which use the default
+// parameters.
+var (
+	elasticIn, _, _ = Elastic(1, .375)
+	backIn, _, _    = Back(2)
+	bounceIn, _, _  = Bounce(3, .5)
+)
+
+// Elastic creates an Elastic (rubber band) transition that oscillates with
+// the amplitude and the period, as a fraction of the transition, before
+// settling at its end. An amplitude below 1 is 1. ElasticIn, ElasticOut and
+// ElasticInOut are Elastic(1, 0.375).
+func Elastic(amplitude, period float64) (in, out, inOut tween.TransitionFunc) {
+	if amplitude < 1 {
+		amplitude = 1
+	}
+	// shift is the phase that makes the oscillation end at 1
+	shift := period / (2 * math.Pi) * math.Asin(1/amplitude)
+	return eases(func(completed float64) float64 {
+		if completed == 0 || completed == 1 {
+			return completed
+		}
+		return -amplitude * math.Pow(2, 8*(completed-1)) * math.Sin((completed-1-shift)*2*math.Pi/period)
+	})
+}
+
+// Back creates a Back transition that first moves in reverse by an amount
+// set by overshoot (0 doesn't reverse at all). BackIn, BackOut and BackInOut
+// are Back(2).
+func Back(overshoot float64) (in, out, inOut tween.TransitionFunc) {
+	return eases(func(completed float64) float64 {
+		return completed * completed * ((overshoot+1)*completed - overshoot)
+	})
+}
+
+// Bounce creates a Bounce transition (like a rubber ball) that bounces the
+// number of bounces before it settles. Each bounce keeps the restitution of
+// the speed of the one before, between 0 (dead) and 1 (perfectly elastic), so
+// it reaches restitution² of its height in restitution of its time. BounceIn,
+// BounceOut and BounceInOut are Bounce(3, 0.5).
+func Bounce(bounces int, restitution float64) (in, out, inOut tween.TransitionFunc) {
+	restitution = math.Max(0, math.Min(restitution, .999))
+
+	// fall is the time of the fall to the first bounce, every bounce after it
+	// takes twice the time of its fall
+	total := 1.
+	for k := 1; k <= bounces; k++ {
+		total += 2 * math.Pow(restitution, float64(k))
+	}
+	fall := 1 / total
+	gravity := 1 / (fall * fall)
+
+	return eases(func(completed float64) float64 {
+		if completed == 0 || completed == 1 {
+			return completed
+		}
+		// the bounces play backwards from the start, the fall ends at 1
+		remaining := 1 - completed
+		if remaining < fall {
+			return 1 - gravity*remaining*remaining
+		}
+		start := fall
+		for k := 1; k <= bounces; k++ {
+			speed := math.Pow(restitution, float64(k))
+			width := 2 * fall * speed
+			if remaining < start+width || k == bounces {
+				middle := start + width/2
+				return math.Max(0, speed*speed-gravity*(remaining-middle)*(remaining-middle))
+			}
+			start += width
+		}
+		return 0
+	})
+}
+
+// eases returns the In, Out and InOut transitions of the In transition in.
+func eases(in tween.TransitionFunc) (tween.TransitionFunc, tween.TransitionFunc, tween.TransitionFunc) {
+	return in, Out(in), InOut(in)
+}
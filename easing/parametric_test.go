@@ -0,0 +1,90 @@
+package easing_test
+
+import (
+	"github.com/draoncc/tween"
+	. "github.com/draoncc/tween/easing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// expectSame compares two transitions across the whole transition.
+func expectSame(f, g tween.TransitionFunc) {
+	for completed := 0.; completed <= 1; completed += .01 {
+		Ω(f(completed)).Should(BeNumerically("~", g(completed), 1e-12), "at %v", completed)
+	}
+}
+
+// minimum returns the smallest value of the transition.
+func minimum(f tween.TransitionFunc) float64 {
+	min := 0.
+	for completed := 0.; completed <= 1; completed += .001 {
+		if v := f(completed); v < min {
+			min = v
+		}
+	}
+	return min
+}
+
+var _ = Describe("Parametric Curves", func() {
+	Describe("Elastic", func() {
+		It("should default to the named functions", func() {
+			in, out, inOut := Elastic(1, .375)
+			expectSame(in, ElasticIn)
+			expectSame(out, ElasticOut)
+			expectSame(inOut, ElasticInOut)
+		})
+		It("should tune the oscillation", func() {
+			in, _, _ := Elastic(2, .2)
+			Ω(in(0)).Should(Equal(0.))
+			Ω(in(1)).Should(Equal(1.))
+			Ω(minimum(in)).Should(BeNumerically("<", minimum(ElasticIn)))
+		})
+	})
+	Describe("Back", func() {
+		It("should default to the named functions", func() {
+			in, out, inOut := Back(2)
+			expectSame(in, BackIn)
+			expectSame(out, BackOut)
+			expectSame(inOut, BackInOut)
+		})
+		It("should tune the overshoot", func() {
+			in, _, _ := Back(0)
+			expectSame(in, CubicIn)
+			in, _, _ = Back(1.70158)
+			// the classic Penner overshoot goes back by 10%
+			Ω(minimum(in)).Should(BeNumerically("~", -.1, .001))
+		})
+	})
+	Describe("Bounce", func() {
+		It("should default to the named functions", func() {
+			in, out, inOut := Bounce(3, .5)
+			expectSame(in, BounceIn)
+			expectSame(out, BounceOut)
+			expectSame(inOut, BounceInOut)
+			Ω(BounceIn(0)).Should(Equal(0.))
+			Ω(BounceOut(1)).Should(Equal(1.))
+		})
+		It("should tune the bounces", func() {
+			_, out, _ := Bounce(5, .7)
+			Ω(out(0)).Should(Equal(0.))
+			Ω(out(1)).Should(Equal(1.))
+			// count the times the ball hits the ground after the fall
+			hits, falling := 0, true
+			previous := out(0)
+			for completed := .001; completed <= 1; completed += .001 {
+				v := out(completed)
+				if falling && v < previous {
+					hits++
+				}
+				falling = v >= previous
+				previous = v
+			}
+			Ω(hits).Should(Equal(5))
+		})
+		It("should settle straight away without bounces", func() {
+			in, _, _ := Bounce(0, .5)
+			expectSame(in, QuadOut)
+		})
+	})
+})
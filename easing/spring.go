@@ -0,0 +1,120 @@
+package easing
+
+import (
+	"math"
+	"time"
+
+	"github.com/draoncc/tween"
+)
+
+// SpringTolerance is how close to its end value, as a fraction of the
+// transition, a Spring has to stay to count as settled.
+const SpringTolerance = 0.001
+
+// maxSettle is the longest a spring is played for, e.g. when it has no
+// damping and never settles.
+const maxSettle = time.Minute
+
+// Spring creates a damped spring transition from the stiffness, damping and
+// mass of the spring, like the springs of iOS or Framer, along with the
+// duration it takes to settle within SpringTolerance of its end value. The
+// spring starts with the initialVelocity towards its end value, in transitions
+// per second. See SpringOptions.
+func Spring(stiffness, damping, mass, initialVelocity float64) (tween.TransitionFunc, time.Duration) {
+	return SpringOptions{
+		Stiffness:       stiffness,
+		Damping:         damping,
+		Mass:            mass,
+		InitialVelocity: initialVelocity,
+	}.Settle(SpringTolerance)
+}
+
+// SpringOptions describe a damped spring, pulling a mass from the start value
+// of a transition to its end value. Use tween.NewSettlingEngine to run a tween
+// for as long as the spring takes to settle.
+type SpringOptions struct {
+	Stiffness       float64 // Stiffness is the spring constant (defaults to 1).
+	Damping         float64 // Damping is the friction slowing the spring down (none never settles).
+	Mass            float64 // Mass is the mass on the spring (defaults to 1).
+	InitialVelocity float64 // InitialVelocity is the starting speed towards the end value, in transitions per second.
+}
+
+// Settle returns the transition of the spring, and the duration it takes
+// until it stays within tolerance of its end value (at most a minute, rounded
+// up to the millisecond). The transition plays the spring over that duration
+// and ends exactly at its end value.
+func (s SpringOptions) Settle(tolerance float64) (tween.TransitionFunc, time.Duration) {
+	displacement, bound := s.displacement()
+
+	// find the last time the spring is out of tolerance, until the bound of
+	// its displacement keeps it within tolerance for good
+	settle := time.Millisecond
+	for t := time.Duration(0); t < maxSettle && bound(t.Seconds()) >= tolerance; t += time.Millisecond {
+		if math.Abs(displacement(t.Seconds())) >= tolerance {
+			settle = t + time.Millisecond
+		}
+	}
+	if settle > maxSettle {
+		settle = maxSettle
+	}
+
+	seconds := settle.Seconds()
+	return func(completed float64) float64 {
+		if completed <= 0 || completed >= 1 {
+			return math.Max(0, math.Min(completed, 1))
+		}
+		return 1 - displacement(completed*seconds)
+	}, settle
+}
+
+// displacement returns the distance of the spring from its end value at t
+// seconds, starting at 1, and a bound that the distance never leaves after t.
+func (s SpringOptions) displacement() (displacement, bound func(t float64) float64) {
+	k, c, m := s.Stiffness, s.Damping, s.Mass
+	if k <= 0 {
+		k = 1
+	}
+	if m <= 0 {
+		m = 1
+	}
+	v := -s.InitialVelocity // the velocity of the displacement
+
+	omega := math.Sqrt(k / m)        // the undamped angular frequency
+	zeta := c / (2 * math.Sqrt(k*m)) // the damping ratio
+	switch {
+	case zeta < 1:
+		// under damped - oscillates around the end value
+		decay := zeta * omega
+		omegaD := omega * math.Sqrt(1-zeta*zeta)
+		a, b := 1., (decay+v)/omegaD
+		amplitude := math.Hypot(a, b)
+		displacement = func(t float64) float64 {
+			return math.Exp(-decay*t) * (a*math.Cos(omegaD*t) + b*math.Sin(omegaD*t))
+		}
+		bound = func(t float64) float64 {
+			return amplitude * math.Exp(-decay*t)
+		}
+	case zeta == 1:
+		// critically damped - the fastest approach without oscillating
+		a, b := 1., v+omega
+		displacement = func(t float64) float64 {
+			return (a + b*t) * math.Exp(-omega*t)
+		}
+		bound = func(t float64) float64 {
+			return (math.Abs(a) + math.Abs(b)*t) * math.Exp(-omega*t)
+		}
+	default:
+		// over damped - creeps towards the end value
+		root := math.Sqrt(zeta*zeta - 1)
+		r1, r2 := -omega*(zeta-root), -omega*(zeta+root)
+		c2 := (v - r1) / (r2 - r1)
+		c1 := 1 - c2
+		displacement = func(t float64) float64 {
+			return c1*math.Exp(r1*t) + c2*math.Exp(r2*t)
+		}
+		bound = func(t float64) float64 {
+			return math.Abs(c1)*math.Exp(r1*t) + math.Abs(c2)*math.Exp(r2*t)
+		}
+	}
+	return displacement, bound
+}
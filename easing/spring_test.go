@@ -0,0 +1,59 @@
+package easing_test
+
+import (
+	"math"
+	"time"
+
+	. "github.com/draoncc/tween/easing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Spring", func() {
+	It("should settle a critically damped spring without overshooting", func() {
+		f, settle := Spring(100, 20, 1, 0)
+		// (1 + 10t)e^-10t falls below the tolerance after 0.9233s
+		Ω(settle).Should(Equal(924 * time.Millisecond))
+		Ω(f(0)).Should(Equal(0.))
+		Ω(f(1)).Should(Equal(1.))
+		previous := 0.
+		for completed := .01; completed < 1; completed += .01 {
+			Ω(f(completed)).Should(BeNumerically(">=", previous))
+			Ω(f(completed)).Should(BeNumerically("<", 1))
+			previous = f(completed)
+		}
+		t := .5 * settle.Seconds()
+		Ω(f(.5)).Should(BeNumerically("~", 1-(1+10*t)*math.Exp(-10*t), 1e-12))
+	})
+	It("should overshoot an under damped spring", func() {
+		f, settle := Spring(100, 10, 1, 0)
+		// the first peak is at pi / omega and overshoots by e^(-pi zeta / sqrt(1 - zeta^2))
+		peak := math.Pi / (10 * math.Sqrt(.75)) / settle.Seconds()
+		Ω(f(peak)).Should(BeNumerically("~", 1.163034, 1e-6))
+		Ω(f(1 - 1e-9)).Should(BeNumerically("~", 1, SpringTolerance))
+	})
+	It("should creep with an over damped spring", func() {
+		f, settle := Spring(100, 50, 1, 0)
+		critical, criticalSettle := Spring(100, 20, 1, 0)
+		Ω(settle).Should(BeNumerically(">", criticalSettle))
+		t := 100 * time.Millisecond
+		Ω(f(t.Seconds() / settle.Seconds())).Should(BeNumerically("<", critical(t.Seconds()/criticalSettle.Seconds())))
+	})
+	It("should start with the initial velocity", func() {
+		f, settle := Spring(100, 20, 1, 5)
+		h := 1e-6
+		velocity := f(h) / h / settle.Seconds()
+		Ω(velocity).Should(BeNumerically("~", 5, 1e-3))
+	})
+	It("should give up on a spring that never settles", func() {
+		_, settle := Spring(100, 0, 1, 0)
+		Ω(settle).Should(Equal(time.Minute))
+	})
+	It("should settle within a tolerance", func() {
+		spring := SpringOptions{Stiffness: 100, Damping: 20, Mass: 1}
+		_, loose := spring.Settle(.01)
+		_, tight := spring.Settle(.0001)
+		Ω(loose).Should(BeNumerically("<", tight))
+	})
+})
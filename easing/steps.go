@@ -0,0 +1,55 @@
+package easing
+
+import (
+	"math"
+
+	"github.com/draoncc/tween"
+)
+
+// StepPosition decides where the jumps of a Steps transition happen, like
+// the position of the CSS steps() timing function.
+type StepPosition int
+
+const (
+	JumpEnd   StepPosition = iota // JumpEnd jumps at the end of each step, the CSS default (also known as end).
+	JumpStart                     // JumpStart jumps at the start of each step (also known as start).
+	JumpNone                      // JumpNone holds the start and end values for a step each, without jumping at either end.
+	JumpBoth                      // JumpBoth jumps at both the start and the end, adding a step.
+)
+
+// Steps creates a discrete transition that moves in n equal jumps, exactly
+// like the CSS steps(n, position) timing function. n is at least 1, or 2 for
+// JumpNone.
+func Steps(n int, position StepPosition) tween.TransitionFunc {
+	var jumps int // jumps is the number of jumps from 0 to 1
+	switch position {
+	case JumpNone:
+		if n < 2 {
+			n = 2
+		}
+		jumps = n - 1
+	case JumpBoth:
+		if n < 1 {
+			n = 1
+		}
+		jumps = n + 1
+	default:
+		if n < 1 {
+			n = 1
+		}
+		jumps = n
+	}
+	return func(completed float64) float64 {
+		step := int(math.Floor(completed * float64(n)))
+		if position == JumpStart || position == JumpBoth {
+			step++
+		}
+		if completed >= 0 && step < 0 {
+			step = 0
+		}
+		if completed <= 1 && step > jumps {
+			step = jumps
+		}
+		return float64(step) / float64(jumps)
+	}
+}
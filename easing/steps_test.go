@@ -0,0 +1,56 @@
+package easing_test
+
+import (
+	"time"
+
+	"github.com/draoncc/tween"
+	. "github.com/draoncc/tween/easing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// sample returns the transition at 0, 0.1, 0.2 ... 1.
+func sample(f tween.TransitionFunc) []float64 {
+	values := []float64{}
+	for i := 0; i <= 10; i++ {
+		values = append(values, f(float64(i)/10))
+	}
+	return values
+}
+
+var _ = Describe("Steps", func() {
+	It("should jump at the end of each step", func() {
+		Ω(sample(Steps(4, JumpEnd))).Should(Equal([]float64{0, 0, 0, .25, .25, .5, .5, .5, .75, .75, 1}))
+	})
+	It("should jump at the start of each step", func() {
+		Ω(sample(Steps(4, JumpStart))).Should(Equal([]float64{.25, .25, .25, .5, .5, .75, .75, .75, 1, 1, 1}))
+	})
+	It("should not jump at either end", func() {
+		Ω(sample(Steps(5, JumpNone))).Should(Equal([]float64{0, 0, .25, .25, .5, .5, .75, .75, 1, 1, 1}))
+	})
+	It("should jump at both ends", func() {
+		Ω(sample(Steps(3, JumpBoth))).Should(Equal([]float64{.25, .25, .25, .25, .5, .5, .5, .75, .75, .75, 1}))
+	})
+	It("should have at least one step", func() {
+		Ω(sample(Steps(0, JumpEnd))).Should(Equal([]float64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}))
+		Ω(Steps(1, JumpNone)(.4)).Should(Equal(0.))
+		Ω(Steps(1, JumpNone)(.6)).Should(Equal(1.))
+	})
+	It("should drive an Engine", func() {
+		values := []float64{}
+		engine := tween.NewEngine(time.Second, Steps(2, JumpEnd), updater(func(frame tween.Frame) {
+			values = append(values, frame.Transitioned)
+		}))
+		engine.Framerate = tween.FPS(4)
+		engine.Render()
+		Ω(values).Should(Equal([]float64{0, 0, .5, .5, 1}))
+	})
+})
+
+// updater is an Updater that calls itself with every frame.
+type updater func(frame tween.Frame)
+
+func (u updater) Start(info tween.StartInfo) {}
+func (u updater) Update(frame tween.Frame)   { u(frame) }
+func (u updater) End()                       {}
@@ -0,0 +1,69 @@
+package tween
+
+import (
+	"fmt"
+	"math/bits"
+	"time"
+)
+
+// Framerate is a number of frames per second, kept as the fraction Frames /
+// Seconds so that rates such as 29.97fps video (30000/1001) are exact.
+type Framerate struct {
+	Frames  int // Frames is the number of frames played in Seconds.
+	Seconds int // Seconds is the number of seconds the Frames take (defaults to 1).
+}
+
+// FPS returns the framerate of n frames per second.
+func FPS(n int) Framerate {
+	return Framerate{Frames: n, Seconds: 1}
+}
+
+// PerSecond returns the number of frames per second.
+func (f Framerate) PerSecond() float64 {
+	return float64(f.Frames) / float64(f.seconds())
+}
+
+// String formats the framerate, e.g. "60fps" or "30000/1001fps".
+func (f Framerate) String() string {
+	if f.seconds() == 1 {
+		return fmt.Sprintf("%dfps", f.Frames)
+	}
+	return fmt.Sprintf("%d/%dfps", f.Frames, f.seconds())
+}
+
+// seconds returns the number of seconds the frames take.
+func (f Framerate) seconds() int {
+	if f.Seconds == 0 {
+		return 1
+	}
+	return f.Seconds
+}
+
+// frameTime returns the time of the frame index: the first nanosecond at or
+// after index frames.
+func (f Framerate) frameTime(index int) time.Duration {
+	return time.Duration(mulDiv(uint64(index), uint64(time.Second)*uint64(f.seconds()), uint64(f.Frames), true))
+}
+
+// frameIndex returns the index of the frame the time d falls in.
+func (f Framerate) frameIndex(d time.Duration) int {
+	return int(mulDiv(uint64(d), uint64(f.Frames), uint64(time.Second)*uint64(f.seconds()), false))
+}
+
+// mulDiv returns a * b / c without overflowing the product, rounded up or
+// down, and saturated to fit an int64.
+func mulDiv(a, b, c uint64, up bool) uint64 {
+	const max = 1<<63 - 1
+	hi, lo := bits.Mul64(a, b)
+	if hi >= c {
+		return max // the quotient doesn't fit, saturate rather than panic
+	}
+	q, r := bits.Div64(hi, lo, c)
+	if up && r != 0 {
+		q++
+	}
+	if q > max {
+		return max
+	}
+	return q
+}
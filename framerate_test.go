@@ -0,0 +1,43 @@
+package tween_test
+
+import (
+	"time"
+
+	. "github.com/draoncc/tween"
+	"github.com/draoncc/tween/easing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Framerate", func() {
+	It("should describe the framerate", func() {
+		ntsc := Framerate{Frames: 30000, Seconds: 1001}
+		Ω(FPS(60).String()).Should(Equal("60fps"))
+		Ω(ntsc.String()).Should(Equal("30000/1001fps"))
+		Ω(ntsc.PerSecond()).Should(BeNumerically("~", 29.97, .001))
+		Ω(Framerate{Frames: 24}.PerSecond()).Should(Equal(24.))
+	})
+	It("should lay out fractional frames exactly", func() {
+		recorder := &Recorder{Done: make(chan int, 1)}
+		engine := NewEngine(1001*time.Millisecond, easing.Linear, recorder)
+		engine.Framerate = Framerate{Frames: 30000, Seconds: 1001}
+		engine.Render()
+		Ω(recorder.TotalFrames).Should(Equal(30))
+		Ω(recorder.FTime).Should(Equal(33366667 * time.Nanosecond))
+		Ω(recorder.Frames).Should(HaveLen(31))
+		Ω(recorder.Frames[3].Elapsed).Should(Equal(100100 * time.Microsecond))
+		Ω(recorder.Frames[29].Elapsed).Should(Equal(967633334 * time.Nanosecond))
+		Ω(recorder.Last().Index).Should(Equal(30))
+		Ω(recorder.Last().Elapsed).Should(Equal(1001 * time.Millisecond))
+	})
+	It("should not overflow long tweens", func() {
+		recorder := &Recorder{Done: make(chan int, 1)}
+		engine := NewEngine(24*time.Hour, easing.Linear, recorder)
+		engine.Framerate = Framerate{Frames: 30000, Seconds: 1001}
+		engine.Step(0)
+		engine.SeekProgress(.5)
+		Ω(recorder.Last().Index).Should(Equal(1294705))
+		Ω(recorder.Last().Completed).Should(BeNumerically("~", .5, 1e-6))
+	})
+})
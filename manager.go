@@ -0,0 +1,236 @@
+package tween
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// NewManager creates a Manager with a framerate of 60fps.
+func NewManager() *Manager {
+	return &Manager{Framerate: FPS(60)}
+}
+
+// Manager drives any number of tweens from a single goroutine and ticker,
+// stepping every tween on each tick. This is much cheaper than running a
+// goroutine and ticker per Engine when there are many tweens at once.
+//
+// Tweens can be added and removed at any time, also while the Manager is
+// running and from within an Updater. A tween added to a Manager is driven by
+// Step and must not be started on its own. Tweens are removed from the
+// Manager once they have ended.
+type Manager struct {
+	Framerate Framerate // The number of ticks per second (defaults to 60 fps).
+	Clock     Clock     // Clock provides the time for the Manager (defaults to SystemClock).
+
+	mu      sync.Mutex    // mu guards the tweens and the running state
+	entries []*entry      // entries are the tweens driven by the Manager
+	running bool          // True if the Manager is running
+	done    chan struct{} // Internal channel used to stop the Manager
+	pool    sync.Pool     // pool recycles the Engines created by Tween
+	scale   float64       // scale is the time scale set by SetTimeScale
+	scaled  bool          // True if the time scale has been set (it is 1 otherwise)
+
+	// stepped and ended are scratch space reused by every Tick
+	stepped []*entry
+	ended   []*entry
+}
+
+// entry is a tween driven by a Manager.
+type entry struct {
+	engine  *Engine   // engine is the tween
+	last    time.Time // last is when the tween was last stepped (zero once the Manager is started again)
+	started bool      // True once the tween has been stepped
+	removed bool      // True once the tween has been removed
+	pooled  bool      // True if the Engine is returned to the pool once it ends
+}
+
+// Add drives t from the ticks of the Manager. t starts on the next tick.
+func (m *Manager) Add(t Tween) {
+	m.add(t.engine(), false)
+}
+
+// Tween plays a tween driven by the Manager, like NewEngine followed by Add,
+// that runs to its end and can't be controlled on its own. The Engine of the
+// tween is taken from a pool of finished tweens, and goes back to the pool
+// once it ends. Use Add with an Engine of your own to control a tween.
+func (m *Manager) Tween(duration time.Duration, transition TransitionFunc, updater Updater) {
+	e, _ := m.pool.Get().(*Engine)
+	if e == nil {
+		e = &Engine{}
+	}
+	e.Duration = duration
+	e.Transition = transition
+	e.Updater = updater
+	e.Framerate = m.Framerate
+	m.add(e, true)
+}
+
+// Remove stops driving t. The tween is left as it is: it is not ended.
+func (m *Manager) Remove(t Tween) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, en := range m.entries {
+		if en.engine == t.engine() {
+			en.removed = true
+		}
+	}
+}
+
+// Len returns the number of tweens driven by the Manager.
+func (m *Manager) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := 0
+	for _, en := range m.entries {
+		if !en.removed {
+			n++
+		}
+	}
+	return n
+}
+
+// Start begins driving the tweens. Start is ignored if the Manager is already
+// running.
+func (m *Manager) Start() {
+	m.StartContext(context.Background())
+}
+
+// StartContext begins driving the tweens like Start, until ctx is done.
+func (m *Manager) StartContext(ctx context.Context) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.running {
+		return
+	}
+	m.running = true
+	m.done = make(chan struct{})
+	done := m.done
+	for _, en := range m.entries {
+		// the tweens were frozen while the Manager was stopped
+		en.last = time.Time{}
+	}
+
+	go func() {
+		ticker := m.clock().NewTicker(m.Framerate.frameTime(1))
+		defer ticker.Stop()
+		for {
+			select {
+			case now := <-ticker.C():
+				m.Tick(now)
+			case <-done:
+				return
+			case <-ctx.Done():
+				m.mu.Lock()
+				m.running = false
+				m.mu.Unlock()
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops driving the tweens, which freeze where they are until the
+// Manager is started again.
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.running {
+		m.running = false
+		close(m.done)
+	}
+}
+
+// SetTimeScale sets how fast all the tweens of the Manager play, on top of
+// their own time scales. See Engine.SetTimeScale.
+func (m *Manager) SetTimeScale(scale float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.scale, m.scaled = scale, true
+}
+
+// TimeScale returns the time scale of the Manager.
+func (m *Manager) TimeScale() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.scaled {
+		return 1
+	}
+	return m.scale
+}
+
+// Tick steps every tween to the time now. Tick is called by the Manager
+// goroutine while the Manager is running, and may be called directly instead
+// of starting the Manager to drive the tweens from a loop of your own. Tick
+// must not be called concurrently, nor while the Manager is running.
+func (m *Manager) Tick(now time.Time) {
+	m.mu.Lock()
+	entries := m.stepped[:0]
+	for _, en := range m.entries {
+		if !en.removed {
+			entries = append(entries, en)
+		}
+	}
+	timeScale := 1.
+	if m.scaled {
+		timeScale = m.scale
+	}
+	m.mu.Unlock()
+
+	ended := m.ended[:0]
+	for _, en := range entries {
+		// a tween starts on its first tick, and carries on from where it
+		// was on the first tick after the Manager was started again
+		start := !en.started
+		var dt time.Duration
+		if !start && !en.last.IsZero() {
+			dt = scale(now.Sub(en.last), timeScale)
+		}
+		en.last, en.started = now, true
+		if !en.engine.step(dt, start) {
+			ended = append(ended, en)
+		}
+	}
+
+	// drop the removed tweens, recycling the pooled ones that ended
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, en := range ended {
+		en.removed = true
+		ended[i] = nil
+	}
+	for i := range entries {
+		entries[i] = nil
+	}
+	m.stepped, m.ended = entries, ended[:0]
+	kept := m.entries[:0]
+	for _, en := range m.entries {
+		switch {
+		case !en.removed:
+			kept = append(kept, en)
+		case en.pooled && en.engine.State() == Finished:
+			*en.engine = Engine{}
+			m.pool.Put(en.engine)
+		}
+	}
+	for i := len(kept); i < len(m.entries); i++ {
+		m.entries[i] = nil
+	}
+	m.entries = kept
+}
+
+// add drives e from the ticks of the Manager.
+func (m *Manager) add(e *Engine, pooled bool) {
+	en := &entry{engine: e, pooled: pooled}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = append(m.entries, en)
+}
+
+// clock returns the Clock of the Manager, or SystemClock if it has none.
+func (m *Manager) clock() Clock {
+	if m.Clock == nil {
+		return SystemClock
+	}
+	return m.Clock
+}
@@ -0,0 +1,216 @@
+package tween_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/draoncc/tween"
+	"github.com/draoncc/tween/easing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manager", func() {
+	It("should step every tween on each tick", func() {
+		start := time.Now()
+		manager := NewManager()
+		a, ra := child(time.Second)
+		b, rb := child(500 * time.Millisecond)
+		manager.Add(a)
+		manager.Add(b)
+		Ω(manager.Len()).Should(Equal(2))
+		for i := 0; i <= 4; i++ {
+			manager.Tick(start.Add(time.Duration(i) * 250 * time.Millisecond))
+		}
+		Ω(completed(ra)).Should(Equal([]float64{0, .25, .5, .75, 1}))
+		Ω(completed(rb)).Should(Equal([]float64{0, .5, 1}))
+		for i := 1; i < len(ra.Frames); i++ {
+			Ω(ra.Frames[i].Index).Should(BeNumerically(">", ra.Frames[i-1].Index))
+		}
+		Ω(ra.Done).Should(HaveLen(1))
+		Ω(rb.Done).Should(HaveLen(1))
+		Ω(manager.Len()).Should(Equal(0))
+	})
+	It("should add and remove tweens while running", func(done Done) {
+		clock := NewFakeClock(time.Now())
+		manager := NewManager()
+		manager.Clock = clock
+		manager.Start()
+		defer manager.Stop()
+		a, ra := child(time.Second)
+		b, rb := child(time.Second)
+		manager.Add(a)
+		manager.Add(b)
+		Eventually(func() int {
+			clock.Advance(250 * time.Millisecond)
+			return rb.Count()
+		}).Should(BeNumerically(">=", 3))
+		manager.Remove(b)
+		count := rb.Count()
+		clock.Advance(time.Second)
+		Eventually(a.Done()).Should(BeClosed())
+		Ω(rb.Count()).Should(Equal(count))
+		Ω(rb.Done).Should(BeEmpty())
+		Ω(ra.Done).Should(HaveLen(1))
+		Ω(manager.Len()).Should(Equal(0))
+		close(done)
+	}, 2)
+	It("should not restart a stopped tween", func() {
+		start := time.Now()
+		manager := NewManager()
+		a, ra := child(time.Second)
+		manager.Add(a)
+		manager.Tick(start.Add(250 * time.Millisecond))
+		a.Stop()
+		manager.Tick(start.Add(500 * time.Millisecond))
+		Ω(ra.Starts).Should(Equal(1))
+		Ω(manager.Len()).Should(Equal(0))
+	})
+	It("should scale the time of every tween", func() {
+		start := time.Now()
+		manager := NewManager()
+		manager.SetTimeScale(.5)
+		a, ra := child(time.Second)
+		b, rb := child(time.Second)
+		b.SetTimeScale(2)
+		manager.Add(a)
+		manager.Add(b)
+		manager.Tick(start)
+		manager.Tick(start.Add(500 * time.Millisecond))
+		Ω(ra.Last().Completed).Should(Equal(.25))
+		Ω(rb.Last().Completed).Should(Equal(.5))
+		Ω(manager.TimeScale()).Should(Equal(.5))
+	})
+	It("should freeze the tweens while stopped", func(done Done) {
+		clock := NewFakeClock(time.Now())
+		manager := NewManager()
+		manager.Clock = clock
+		a, ra := child(10 * time.Second)
+		manager.Add(a)
+		manager.Start()
+		Eventually(func() float64 {
+			clock.Advance(250 * time.Millisecond)
+			return ra.Last().Completed
+		}).Should(BeNumerically(">=", .1))
+		manager.Stop()
+		clock.Advance(5 * time.Second)
+		manager.Start()
+		defer manager.Stop()
+		Eventually(func() float64 {
+			clock.Advance(250 * time.Millisecond)
+			return ra.Last().Completed
+		}).Should(BeNumerically(">=", .2))
+		ra.Lock()
+		defer ra.Unlock()
+		for _, frame := range ra.Frames {
+			Ω(frame.Delta).Should(BeNumerically("<", time.Second))
+		}
+		close(done)
+	}, 2)
+	It("should recycle pooled tweens", func() {
+		start := time.Now()
+		manager := NewManager()
+		recorder := &Recorder{Done: make(chan int, 10)}
+		for i := 0; i < 3; i++ {
+			manager.Tween(time.Second, easing.Linear, recorder)
+			manager.Tick(start.Add(time.Duration(i) * time.Second))
+			manager.Tick(start.Add(time.Duration(i+1) * time.Second))
+		}
+		Ω(recorder.Starts).Should(Equal(3))
+		Ω(recorder.Done).Should(HaveLen(3))
+		Ω(manager.Len()).Should(Equal(0))
+	})
+	It("should leave the tweens it was given alone once they end", func() {
+		start := time.Now()
+		manager := NewManager()
+		a, _ := child(time.Second)
+		manager.Add(a)
+		result := make(chan Result)
+		go func() { result <- a.Wait() }()
+		manager.Tick(start)
+		manager.Tick(start.Add(time.Second))
+		Ω(<-result).Should(Equal(Completed))
+		Ω(a.State()).Should(Equal(Finished))
+		Ω(a.Duration).Should(Equal(time.Second))
+	})
+})
+
+// counter is an Updater that counts the frames sent to all its tweens.
+type counter struct {
+	frames *sync.WaitGroup
+}
+
+func (c counter) Start(info StartInfo) {}
+func (c counter) Update(frame Frame)   { c.frames.Done() }
+func (c counter) End()                 {}
+
+// benchmarkTweens is the number of tweens driven in each benchmark.
+const benchmarkTweens = 1000
+
+// benchmarkTick is the time between the ticks of a benchmark, which is more
+// than a frame at 60fps so that each tick is due a new frame.
+const benchmarkTick = 20 * time.Millisecond
+
+// benchmarkFrames sends b.N frames to benchmarkTweens tweens, with tick
+// sending a tick at the time of the next frame, and reports the time it takes
+// per tween and frame.
+func benchmarkFrames(b *testing.B, frames *sync.WaitGroup, tick func(at time.Time)) {
+	at := time.Now()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		at = at.Add(benchmarkTick)
+		frames.Add(benchmarkTweens)
+		tick(at)
+		frames.Wait()
+	}
+	b.StopTimer()
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*benchmarkTweens), "ns/tween")
+}
+
+// BenchmarkEngines drives every tween from its own goroutine and ticker, each
+// ticking on a vsync signal so that the benchmark decides when frames are due.
+func BenchmarkEngines(b *testing.B) {
+	frames := &sync.WaitGroup{}
+	frames.Add(benchmarkTweens)
+	engines := make([]*Engine, benchmarkTweens)
+	vsyncs := make([]chan time.Time, benchmarkTweens)
+	for i := range engines {
+		vsyncs[i] = make(chan time.Time)
+		engines[i] = NewEngine(time.Hour, easing.Linear, counter{frames})
+		engines[i].Clock = NewVSyncClock(vsyncs[i])
+		engines[i].Start()
+	}
+	frames.Wait()
+	benchmarkFrames(b, frames, func(at time.Time) {
+		for _, vsync := range vsyncs {
+			vsync <- at
+		}
+	})
+	frames.Add(benchmarkTweens)
+	for _, engine := range engines {
+		engine.Stop()
+		engine.Wait()
+	}
+}
+
+// BenchmarkManager drives every tween from the goroutine and ticker of a
+// Manager, ticking on a vsync signal like BenchmarkEngines.
+func BenchmarkManager(b *testing.B) {
+	frames := &sync.WaitGroup{}
+	vsync := make(chan time.Time)
+	manager := NewManager()
+	manager.Clock = NewVSyncClock(vsync)
+	for i := 0; i < benchmarkTweens; i++ {
+		manager.Add(NewEngine(time.Hour, easing.Linear, counter{frames}))
+	}
+	manager.Start()
+	defer manager.Stop()
+	frames.Add(benchmarkTweens)
+	vsync <- time.Now()
+	frames.Wait()
+	benchmarkFrames(b, frames, func(at time.Time) {
+		vsync <- at
+	})
+}
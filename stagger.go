@@ -0,0 +1,70 @@
+package tween
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// StaggerOrder decides the order in which the tweens of a Stagger start.
+type StaggerOrder int
+
+const (
+	FromStart  StaggerOrder = iota // FromStart starts the first updater first.
+	FromEnd                        // FromEnd starts the last updater first.
+	FromCenter                     // FromCenter starts the middle updater first, then works outwards.
+	FromRandom                     // FromRandom starts the updaters in a random order seeded by Seed.
+)
+
+// StaggerOptions sets how the starts of the tweens of a Stagger are offset.
+type StaggerOptions struct {
+	Each   time.Duration // Each is the offset between the starts of consecutive tweens.
+	Spread time.Duration // Spread is the offset between the first and last start, used instead of Each if set.
+	Order  StaggerOrder  // Order decides which updater starts first (defaults to FromStart).
+	Seed   int64         // Seed seeds the FromRandom order.
+}
+
+// Stagger creates a Timeline that plays the same tween for each of the
+// updaters, with their starts offset from each other as set by opts. The
+// Timeline controls the whole group like a single tween.
+func Stagger(duration time.Duration, transition TransitionFunc, updaters []Updater, opts StaggerOptions) *Timeline {
+	ranks := make([]float64, len(updaters))
+	first, last := 0.0, 0.0 // first and last are the lowest and highest ranks
+	var perm []int
+	if opts.Order == FromRandom {
+		perm = rand.New(rand.NewSource(opts.Seed)).Perm(len(updaters))
+	}
+	for i := range updaters {
+		switch opts.Order {
+		case FromEnd:
+			ranks[i] = float64(len(updaters) - 1 - i)
+		case FromCenter:
+			ranks[i] = math.Abs(float64(i) - float64(len(updaters)-1)/2)
+		case FromRandom:
+			ranks[i] = float64(perm[i])
+		default:
+			ranks[i] = float64(i)
+		}
+		if i == 0 || ranks[i] < first {
+			first = ranks[i]
+		}
+		last = math.Max(last, ranks[i])
+	}
+	// the first tween starts straight away, e.g. the middle pair from the
+	// center of an even number of updaters
+	for i := range ranks {
+		ranks[i] -= first
+	}
+	last -= first
+
+	each := float64(opts.Each)
+	if opts.Spread != 0 && last > 0 {
+		each = float64(opts.Spread) / last
+	}
+	tl := NewTimeline()
+	for i, updater := range updaters {
+		offset := time.Duration(math.Round(ranks[i] * each))
+		tl.Add(NewEngine(duration, transition, updater), At(offset))
+	}
+	return tl
+}
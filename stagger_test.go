@@ -0,0 +1,93 @@
+package tween_test
+
+import (
+	"time"
+
+	. "github.com/draoncc/tween"
+	"github.com/draoncc/tween/easing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stagger", func() {
+	var (
+		recorders []*Recorder
+		updaters  []Updater
+	)
+	BeforeEach(func() {
+		recorders, updaters = nil, nil
+		for i := 0; i < 5; i++ {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			recorders = append(recorders, recorder)
+			updaters = append(updaters, recorder)
+		}
+	})
+	// started steps the stagger by 10ms at a time from zero and returns the
+	// indices of the updaters that started on each step.
+	started := func(stagger *Timeline) [][]int {
+		stagger.Framerate = FPS(100)
+		steps := [][]int{}
+		seen := map[int]bool{}
+		for step := 0; step < len(recorders); step++ {
+			if step == 0 {
+				stagger.Step(0)
+			} else {
+				stagger.Step(10 * time.Millisecond)
+			}
+			indices := []int{}
+			for i, recorder := range recorders {
+				if recorder.Starts > 0 && !seen[i] {
+					seen[i] = true
+					indices = append(indices, i)
+				}
+			}
+			steps = append(steps, indices)
+		}
+		return steps
+	}
+	It("should stagger from the start", func() {
+		stagger := Stagger(time.Second, easing.Linear, updaters, StaggerOptions{Each: 10 * time.Millisecond})
+		Ω(stagger.Duration).Should(Equal(time.Second + 40*time.Millisecond))
+		Ω(started(stagger)).Should(Equal([][]int{{0}, {1}, {2}, {3}, {4}}))
+	})
+	It("should stagger from the end over a spread", func() {
+		stagger := Stagger(time.Second, easing.Linear, updaters, StaggerOptions{
+			Spread: 40 * time.Millisecond,
+			Order:  FromEnd,
+		})
+		Ω(stagger.Duration).Should(Equal(time.Second + 40*time.Millisecond))
+		Ω(started(stagger)).Should(Equal([][]int{{4}, {3}, {2}, {1}, {0}}))
+	})
+	It("should stagger from the center", func() {
+		stagger := Stagger(time.Second, easing.Linear, updaters, StaggerOptions{
+			Each:  10 * time.Millisecond,
+			Order: FromCenter,
+		})
+		Ω(stagger.Duration).Should(Equal(time.Second + 20*time.Millisecond))
+		Ω(started(stagger)).Should(Equal([][]int{{2}, {1, 3}, {0, 4}, {}, {}}))
+	})
+	It("should stagger an even number from the center", func() {
+		stagger := Stagger(time.Second, easing.Linear, updaters[:4], StaggerOptions{
+			Spread: 20 * time.Millisecond,
+			Order:  FromCenter,
+		})
+		Ω(stagger.Duration).Should(Equal(time.Second + 20*time.Millisecond))
+		Ω(started(stagger)).Should(Equal([][]int{{1, 2}, {}, {0, 3}, {}, {}}))
+		stagger = Stagger(time.Second, easing.Linear, updaters[:4], StaggerOptions{
+			Each:  10 * time.Millisecond,
+			Order: FromCenter,
+		})
+		Ω(stagger.Duration).Should(Equal(time.Second + 10*time.Millisecond))
+	})
+	It("should stagger in a seeded random order", func() {
+		opts := StaggerOptions{Each: 10 * time.Millisecond, Order: FromRandom, Seed: 42}
+		first := started(Stagger(time.Second, easing.Linear, updaters, opts))
+		for _, recorder := range recorders {
+			recorder.Starts = 0
+		}
+		second := started(Stagger(time.Second, easing.Linear, updaters, opts))
+		Ω(second).Should(Equal(first))
+		Ω(first[1:]).Should(ContainElement(HaveLen(1)))
+	})
+})
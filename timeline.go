@@ -0,0 +1,238 @@
+package tween
+
+import (
+	"math"
+	"time"
+)
+
+// Tween is anything that can be placed on a Timeline: an *Engine or a
+// *Timeline.
+type Tween interface {
+	engine() *Engine
+}
+
+// engine returns the Engine itself, making every Engine a Tween.
+func (e *Engine) engine() *Engine {
+	return e
+}
+
+// Position calculates the offset of a tween on a Timeline from the start and
+// end offsets of the tween added before it (both zero for the first tween).
+type Position func(start, end time.Duration) time.Duration
+
+// At places a tween at offset from the start of the Timeline.
+func At(offset time.Duration) Position {
+	return func(start, end time.Duration) time.Duration {
+		return offset
+	}
+}
+
+// After places a tween gap after the end of the previous tween. A negative
+// gap overlaps the two tweens, e.g. After(-100 * time.Millisecond).
+func After(gap time.Duration) Position {
+	return func(start, end time.Duration) time.Duration {
+		return end + gap
+	}
+}
+
+// With places a tween delay after the start of the previous tween, so With(0)
+// starts both tweens together.
+func With(delay time.Duration) Position {
+	return func(start, end time.Duration) time.Duration {
+		return start + delay
+	}
+}
+
+// NewTimeline creates an empty Timeline with a framerate of 60fps.
+func NewTimeline() *Timeline {
+	tl := &Timeline{}
+	tl.Engine = NewEngine(0, linear, &sequencer{timeline: tl})
+	return tl
+}
+
+// Timeline plays child tweens at offsets along a shared time line. The
+// Timeline is an Engine itself, so it can be started, stepped, paused, seeked,
+// repeated, reversed and time scaled like a single tween. Its Duration is the
+// end of its last child and its Transition maps the progress of the Timeline
+// onto the time line (linear by default).
+//
+// The children are driven by the Timeline: each child Updater receives Start
+// the first time the time line reaches the child, its frames while inside, and
+// a frame at the edge it left by whenever the time line leaves it, e.g. to
+// play it again when the Timeline repeats or is seeked back. Every child that
+// was started receives End once the Timeline ends. A child that repeats
+// forever plays until the Timeline ends and adds nothing to its Duration.
+// The hooks of a child are called along with its Updater: OnComplete when the
+// Timeline ends, or OnStop if it ends part way through the child.
+//
+// Children must be added before the Timeline is started or added to another
+// Timeline, and must not be started on their own.
+type Timeline struct {
+	*Engine
+	children []*child // children are the tweens on the time line in the order added
+}
+
+// Add places t on the time line at pos and returns the Timeline, so that calls
+// can be chained. Offsets before the start of the Timeline are moved to zero.
+func (tl *Timeline) Add(t Tween, pos Position) *Timeline {
+	var start, end time.Duration
+	if n := len(tl.children); n > 0 {
+		start, end = tl.children[n-1].offset, tl.children[n-1].end()
+	}
+	c := &child{engine: t.engine(), offset: pos(start, end)}
+	if c.offset < 0 {
+		c.offset = 0
+	}
+	tl.children = append(tl.children, c)
+	if end := c.end(); end > tl.Duration {
+		tl.Duration = end
+	}
+	return tl
+}
+
+// linear is the default Transition of a Timeline.
+func linear(completed float64) float64 {
+	return completed
+}
+
+// sides of the time line position relative to a child
+const (
+	unvisited   = iota // the child has not been visited since the Timeline started
+	beforeChild        // the position is before the start of the child
+	insideChild        // the position is within the child
+	afterChild         // the position is after the end of the child
+)
+
+// child is a tween placed on a Timeline.
+type child struct {
+	engine  *Engine       // engine is the child tween
+	offset  time.Duration // offset is the start of the child on the time line
+	side    int           // side is where the time line was relative to the child
+	started bool          // True once the child has been sent Start in this run of the Timeline
+	local   time.Duration // local is the time into the child of the last frame sent
+}
+
+// end returns the end of the child on the time line. A child that repeats
+// forever ends where it starts.
+func (c *child) end() time.Duration {
+	total, finite := c.engine.runningTime()
+	if !finite {
+		return c.offset
+	}
+	return c.offset + total
+}
+
+// update moves the child to the time line position.
+func (c *child) update(position time.Duration) {
+	e := c.engine
+	total, finite := e.runningTime()
+	local := position - c.offset
+	side := insideChild
+	if local < 0 {
+		side = beforeChild
+	} else if finite && local > total {
+		side = afterChild
+	}
+
+	switch {
+	case side == insideChild:
+		// the time line decides the frame times, so the child doesn't snap
+		// them to its own frames
+		if !c.started || local != c.local {
+			c.start()
+			e.update(e.frameFor(local, local))
+			c.local = local
+		}
+	case side == c.side, side == beforeChild && !c.started:
+		// still on the same side, or not yet reached
+	default:
+		// leaving or passing over the child - send the frame at the edge it
+		// left by, unless the last frame was already there
+		edge, frame := time.Duration(0), e.frameAt(0)
+		if side == afterChild {
+			edge, frame = total, e.finalFrame(total)
+		}
+		if !c.started || c.local != edge {
+			c.start()
+			e.update(frame)
+			c.local = edge
+		}
+	}
+	c.side = side
+}
+
+// start sends Start to the child, unless it has already started in this run
+// of the Timeline.
+func (c *child) start() {
+	if !c.started {
+		c.started = true
+		c.engine.start(c.engine.startInfo())
+	}
+}
+
+// sequencer is the Updater of a Timeline, forwarding its frames to the
+// children of the Timeline.
+type sequencer struct {
+	timeline *Timeline     // timeline is the Timeline being sequenced
+	position time.Duration // position is the current position on the time line
+}
+
+// Start resets the children of the Timeline.
+func (s *sequencer) Start(info StartInfo) {
+	s.position = 0
+	for _, c := range s.timeline.children {
+		c.side, c.started = unvisited, false
+	}
+}
+
+// Update moves every child to the time line position of the frame. Children
+// are updated in the order added when moving forward, and in reverse order
+// when moving backward.
+func (s *sequencer) Update(frame Frame) {
+	position := time.Duration(math.Round(frame.Transitioned * float64(s.timeline.Duration)))
+	children := s.timeline.children
+	if position < s.position {
+		for i := len(children) - 1; i >= 0; i-- {
+			children[i].update(position)
+		}
+	} else {
+		for _, c := range children {
+			c.update(position)
+		}
+	}
+	s.position = position
+}
+
+// End ends the children that were started. A child the Timeline ended part
+// way through is stopped, any other child completes.
+func (s *sequencer) End() {
+	for _, c := range s.timeline.children {
+		if c.started {
+			result := Completed
+			if total, finite := c.engine.runningTime(); c.side == insideChild && c.local > 0 && (!finite || c.local < total) {
+				result = Stopped
+			}
+			c.engine.stop(result)
+		}
+		c.side, c.started = unvisited, false
+	}
+}
+
+// Sequence creates a Timeline that plays the tweens one after the other.
+func Sequence(tweens ...Tween) *Timeline {
+	tl := NewTimeline()
+	for _, t := range tweens {
+		tl.Add(t, After(0))
+	}
+	return tl
+}
+
+// Parallel creates a Timeline that plays the tweens all at once. It ends with
+// its longest tween.
+func Parallel(tweens ...Tween) *Timeline {
+	tl := NewTimeline()
+	for _, t := range tweens {
+		tl.Add(t, At(0))
+	}
+	return tl
+}
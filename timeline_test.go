@@ -0,0 +1,200 @@
+package tween_test
+
+import (
+	"image/color"
+	"time"
+
+	. "github.com/draoncc/tween"
+	"github.com/draoncc/tween/easing"
+	"github.com/draoncc/tween/updaters"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// completed returns the Completed values of the recorded frames.
+func completed(recorder *Recorder) []float64 {
+	values := []float64{}
+	for _, frame := range recorder.Frames {
+		values = append(values, frame.Completed)
+	}
+	return values
+}
+
+// child creates an Engine running at 4 fps with a recorder.
+func child(duration time.Duration) (*Engine, *Recorder) {
+	recorder := &Recorder{Done: make(chan int, 10)}
+	engine := NewEngine(duration, easing.Linear, recorder)
+	engine.Framerate = FPS(4)
+	return engine, recorder
+}
+
+// colorChild creates an Engine running at 4 fps with a Color updater, whose
+// updates are drained until it is done.
+func colorChild(duration time.Duration) (*Engine, *updaters.Color) {
+	updater := updaters.NewColor(color.RGBA{255, 0, 0, 255}, color.RGBA{0, 0, 255, 255})
+	go func() {
+		for {
+			select {
+			case <-updater.Updates:
+			case <-updater.Done:
+				return
+			}
+		}
+	}()
+	engine := NewEngine(duration, easing.Linear, updater)
+	engine.Framerate = FPS(4)
+	return engine, updater
+}
+
+var _ = Describe("Timeline", func() {
+	var (
+		timeline *Timeline
+		fade     *Recorder
+		slide    *Recorder
+		scale    *Recorder
+	)
+	BeforeEach(func() {
+		fadeEngine, fadeRecorder := child(time.Second)
+		slideEngine, slideRecorder := child(time.Second)
+		scaleEngine, scaleRecorder := child(500 * time.Millisecond)
+		fade, slide, scale = fadeRecorder, slideRecorder, scaleRecorder
+		timeline = NewTimeline().
+			Add(fadeEngine, At(0)).
+			Add(slideEngine, After(0)).
+			Add(scaleEngine, With(500*time.Millisecond))
+		timeline.Framerate = FPS(4)
+	})
+	It("should place tweens on the time line", func() {
+		Ω(timeline.Duration).Should(Equal(2 * time.Second))
+		timeline.Render()
+		Ω(completed(fade)).Should(Equal([]float64{0, .25, .5, .75, 1}))
+		Ω(completed(slide)).Should(Equal([]float64{0, .25, .5, .75, 1}))
+		Ω(completed(scale)).Should(Equal([]float64{0, .5, 1}))
+		for _, recorder := range []*Recorder{fade, slide, scale} {
+			Ω(recorder.Starts).Should(Equal(1))
+			Ω(recorder.Done).Should(HaveLen(1))
+		}
+	})
+	It("should play children at the frames of the time line", func() {
+		engine, recorder := child(time.Second)
+		timeline := NewTimeline().Add(engine, At(100*time.Millisecond))
+		timeline.Framerate = FPS(4)
+		timeline.Hold = 150 * time.Millisecond
+		timeline.Render()
+		Ω(completed(recorder)).Should(Equal([]float64{.15, .4, .65, .9, 1}))
+	})
+	It("should play in reverse", func() {
+		timeline.Reverse = true
+		timeline.Render()
+		Ω(completed(scale)).Should(Equal([]float64{1, .5, 0}))
+		Ω(completed(slide)).Should(Equal([]float64{1, .75, .5, .25, 0}))
+		// the fade is passed over by the first frame, then entered
+		Ω(completed(fade)).Should(Equal([]float64{1, .75, .5, .25, 0}))
+		Ω(fade.Starts).Should(Equal(1))
+		Ω(fade.Done).Should(HaveLen(1))
+	})
+	It("should seek back and forth", func() {
+		timeline.Step(0)
+		timeline.SeekProgress(.75)
+		Ω(fade.Last().Completed).Should(Equal(1.))
+		Ω(slide.Last().Completed).Should(Equal(.5))
+		Ω(scale.Last().Completed).Should(Equal(0.))
+		timeline.Seek(0)
+		Ω(slide.Last().Completed).Should(Equal(0.))
+		Ω(fade.Last().Completed).Should(Equal(0.))
+		for _, recorder := range []*Recorder{fade, slide, scale} {
+			Ω(recorder.Starts).Should(Equal(1))
+			Ω(recorder.Done).Should(BeEmpty())
+		}
+		timeline.Stop()
+		for _, recorder := range []*Recorder{fade, slide, scale} {
+			Ω(recorder.Done).Should(HaveLen(1))
+		}
+	})
+	Describe("Updaters", func() {
+		plays := []struct {
+			name string
+			set  func(timeline *Timeline)
+		}{
+			{"repeated", func(timeline *Timeline) { timeline.Repeat = 1 }},
+			{"played as a yoyo", func(timeline *Timeline) { timeline.Repeat, timeline.Yoyo = 1, true }},
+			{"reversed", func(timeline *Timeline) { timeline.Reverse = true }},
+		}
+		for _, play := range plays {
+			play := play
+			It("should start and end each child once when "+play.name, func(done Done) {
+				a, colorA := colorChild(time.Second)
+				b, colorB := colorChild(time.Second)
+				c, recorder := child(time.Second)
+				timeline := Sequence(a, b, c)
+				timeline.Framerate = FPS(4)
+				play.set(timeline)
+				timeline.Render()
+				Ω(colorA.Done).Should(BeClosed())
+				Ω(colorB.Done).Should(BeClosed())
+				Ω(recorder.Starts).Should(Equal(1))
+				Ω(recorder.Done).Should(HaveLen(1))
+				close(done)
+			}, 2)
+		}
+	})
+	It("should nest timelines", func() {
+		first, firstRecorder := child(time.Second)
+		second, secondRecorder := child(time.Second)
+		inner := NewTimeline().Add(first, At(0)).Add(second, After(0))
+		inner.Framerate = FPS(4)
+		outer := NewTimeline().Add(timeline, At(0)).Add(inner, After(-time.Second))
+		outer.Framerate = FPS(4)
+		Ω(outer.Duration).Should(Equal(3 * time.Second))
+		outer.Render()
+		Ω(completed(slide)).Should(Equal([]float64{0, .25, .5, .75, 1}))
+		Ω(completed(firstRecorder)).Should(Equal([]float64{0, .25, .5, .75, 1}))
+		Ω(completed(secondRecorder)).Should(Equal([]float64{0, .25, .5, .75, 1}))
+		Ω(secondRecorder.Done).Should(HaveLen(1))
+	})
+	It("should play an empty timeline", func() {
+		delayed := NewTimeline()
+		delayed.Framerate = FPS(4)
+		delayed.Delay = 500 * time.Millisecond
+		delayed.Render()
+		Ω(delayed.Step(250 * time.Millisecond)).Should(BeTrue())
+		Ω(delayed.Step(250 * time.Millisecond)).Should(BeFalse())
+		forever := NewTimeline()
+		forever.Repeat = Infinite
+		forever.Render()
+		Ω(forever.Step(time.Second)).Should(BeTrue())
+		forever.Stop()
+		Ω(forever.State()).Should(Equal(Finished))
+	})
+	Describe("Sequence and Parallel", func() {
+		It("should nest sequences and parallels", func() {
+			a, ra := child(time.Second)
+			b, rb := child(500 * time.Millisecond)
+			c, rc := child(time.Second)
+			d, rd := child(time.Second)
+			tween := Sequence(a, Parallel(b, c), d)
+			tween.Framerate = FPS(4)
+			Ω(tween.Duration).Should(Equal(3 * time.Second))
+			tween.Render()
+			Ω(completed(ra)).Should(Equal([]float64{0, .25, .5, .75, 1}))
+			Ω(completed(rb)).Should(Equal([]float64{0, .5, 1}))
+			Ω(completed(rc)).Should(Equal([]float64{0, .25, .5, .75, 1}))
+			Ω(completed(rd)).Should(Equal([]float64{0, .25, .5, .75, 1}))
+			Ω(rd.Frames[0].Elapsed).Should(Equal(time.Duration(0)))
+			for _, recorder := range []*Recorder{ra, rb, rc, rd} {
+				Ω(recorder.Starts).Should(Equal(1))
+				Ω(recorder.Done).Should(HaveLen(1))
+			}
+		})
+		It("should start parallels from a sequence together", func() {
+			a, ra := child(time.Second)
+			b, rb := child(time.Second)
+			tween := Parallel(Sequence(a), Sequence(b))
+			tween.Framerate = FPS(4)
+			tween.Step(500 * time.Millisecond)
+			Ω(ra.Last().Completed).Should(Equal(.5))
+			Ω(rb.Last().Completed).Should(Equal(.5))
+		})
+	})
+})
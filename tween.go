@@ -1,6 +1,11 @@
 package tween
 
-import "time"
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
 
 // TransitionFunc calculates the percentage of the transition between the start
 // and end values based tween (elapsed time) completion status.
@@ -16,12 +21,7 @@ type TransitionFunc func(completed float64) float64
 type Updater interface {
 	// Start signals the beginning of a tween and is sent before the tweening
 	// begins. Start may be used to setup or pre-calculate updates.
-	//
-	// framerate is the number of frames per second in the tween
-	// frames is the total number of frames that be generated
-	// frameTime is the duration for each frame
-	// runningTime is the total duration for the entire tween
-	Start(framerate, frames int, frameTime, runningTime time.Duration)
+	Start(info StartInfo)
 	// Update receives information about the current Tween Frame and should be
 	// used to update output or state.
 	Update(Frame Frame)
@@ -30,107 +30,907 @@ type Updater interface {
 	End()
 }
 
+// StartInfo describes a tween to its Updater when the tween starts.
+type StartInfo struct {
+	Framerate   Framerate     // Framerate is the number of frames per second in the tween.
+	Frames      int           // Frames is the number of frames before the final frame (which has this Index), or Infinite.
+	FrameTime   time.Duration // FrameTime is the duration of each frame.
+	RunningTime time.Duration // RunningTime is the total duration of the tween including repeats, Delay and Hold, or Infinite.
+	Delay       time.Duration // Delay is the time the start value is held before the transition begins.
+	Hold        time.Duration // Hold is the time the end value is held after the transition, before End.
+}
+
 // Frame captures information about the current "frame" of a tween transition.
 type Frame struct {
 	Completed    float64       // Completed is the percentage 0.0 - 1.0 of elapsed time.
 	Transitioned float64       // Transitioned is the percentage 0.0 - 1.0 of transition between start and end values of the tween.
 	Index        int           // Index is the current frame index
 	Elapsed      time.Duration // Elapsed is the current elapsed time in the tween.
+	Iteration    int           // Iteration is the current repetition of the tween, starting at 0.
+	Reversed     bool          // Reversed is true if the current repetition plays from end to start.
+	Dropped      int           // Dropped is the number of frames skipped since the previous frame.
+	Jitter       time.Duration // Jitter is how much later (or earlier) than a frame time the frame came after the previous one.
+	Delta        time.Duration // Delta is the elapsed time since the previous frame, negative if the tween moved back (e.g. by Seek).
+	Velocity     float64       // Velocity is the rate of change of Transitioned per second of elapsed time.
+}
+
+// Stats describes the smoothness of a run of a tween.
+type Stats struct {
+	Frames    int           // Frames is the number of frames played.
+	Dropped   int           // Dropped is the number of frames skipped.
+	Jitter    time.Duration // Jitter is the mean absolute jitter of the frames.
+	MaxJitter time.Duration // MaxJitter is the largest absolute jitter of a frame.
 }
 
+// Infinite is used as a Repeat count to repeat a tween forever.
+const Infinite = -1
+
+// Result describes how a tween ended.
+type Result int
+
+const (
+	Pending   Result = iota // Pending means the tween has not ended yet.
+	Completed               // Completed means the tween ran to its end.
+	Stopped                 // Stopped means the tween was terminated by Stop.
+	Cancelled               // Cancelled means the context of the tween was cancelled.
+)
+
+// State is the state of an Engine. An Engine starts out Idle, is Running or
+// Paused while it runs, and is Finished once it has ended. A Finished Engine
+// can be started again.
+type State int
+
+const (
+	Idle     State = iota // Idle means the tween has never been started.
+	Running               // Running means the tween is running.
+	Paused                // Paused means the tween is running but paused.
+	Finished              // Finished means the tween has ended.
+)
+
+// StopMode decides the final frame sent to the Updater when a tween is stopped
+// or cancelled before it ends.
+type StopMode int
+
+const (
+	StopEnd    StopMode = iota // StopEnd jumps to the end value of the tween.
+	StopFreeze                 // StopFreeze leaves the tween at its current value.
+	StopRevert                 // StopRevert rolls the tween back to its start value.
+)
+
+// CatchUp decides which frames are sent to the Updater when frames are late,
+// e.g. when the system is under load or a Step covers several frames.
+type CatchUp int
+
+const (
+	CatchUpSkip        CatchUp = iota // CatchUpSkip skips straight to the latest frame.
+	CatchUpEvery                      // CatchUpEvery sends every missed frame in turn.
+	CatchUpInterpolate                // CatchUpInterpolate sends a frame at the exact elapsed time rather than at the latest frame time.
+)
+
 // NewEngine creates a basic tween Engine with a framerate of 60fps.
 func NewEngine(duration time.Duration, transition TransitionFunc, updater Updater) *Engine {
 	return &Engine{
 		Duration:   duration,
 		Transition: transition,
 		Updater:    updater,
-		Framerate:  60,
+		Framerate:  FPS(60),
 	}
 }
 
+// Settler is a transition that decides its own duration, such as a spring
+// that plays until it comes to rest (see easing.SpringOptions).
+type Settler interface {
+	// Settle returns the transition and the duration it takes to settle
+	// within tolerance of its end value, as a fraction of the transition.
+	Settle(tolerance float64) (TransitionFunc, time.Duration)
+}
+
+// NewSettlingEngine creates a tween Engine with a framerate of 60fps, whose
+// Duration is decided by the time settler takes to settle within tolerance of
+// its end value.
+func NewSettlingEngine(settler Settler, tolerance float64, updater Updater) *Engine {
+	transition, duration := settler.Settle(tolerance)
+	return NewEngine(duration, transition, updater)
+}
+
 // Engine runs a tween relying on transitioner and updater.
+//
+// The frames of a tween are laid out on a fixed schedule: frame i is at i /
+// Framerate seconds of elapsed time (rounded up to the nanosecond), starting
+// with frame 0 at 0. Every frame before the running time is followed by a
+// final frame exactly at the running time, whose Index follows on from the
+// frame before it, so a one second tween at 60fps has 61 frames indexed 0 to
+// 60. The Index of the frames sent always increases, unless the tween moves
+// back (e.g. by Seek or a negative time scale); late frames are skipped or
+// caught up as set by CatchUp.
+//
+// All methods of an Engine are safe for concurrent use. Start and StartContext
+// are ignored while the tween is running, and Stop is ignored unless it is.
+// The tween settings must not be changed while the tween is running.
 type Engine struct {
-	Duration   time.Duration  // The total duration of the tween.
-	Framerate  int            // The number of tween data points per second (defaults to 60 fps - like the real gamers use).
+	Duration   time.Duration  // The duration of a single run of the tween.
+	Framerate  Framerate      // The number of tween data points per second (defaults to 60 fps - like the real gamers use).
 	Transition TransitionFunc // Transition calculates the transition curve for the tween.
 	Updater    Updater        // Updater updates the tween values for each frame.
+	Repeat     int            // The number of times the tween repeats after the first run, or Infinite.
+	Yoyo       bool           // Yoyo reverses the direction of every other repetition (ping-pong).
+	Reverse    bool           // Reverse plays the tween from end to start.
+	Delay      time.Duration  // Delay holds the start value for a while before the first repetition.
+	Hold       time.Duration  // Hold holds the end value for a while after the last repetition.
+	Clock      Clock          // Clock provides the time for the tween (defaults to SystemClock).
+	StopMode   StopMode       // StopMode decides how Stop and cancellation end the tween (defaults to StopEnd).
+	CatchUp    CatchUp        // CatchUp decides the frames sent when frames are late (defaults to CatchUpSkip).
+
+	// The hooks are optional callbacks, called on the goroutine driving the
+	// tween. OnStart, OnUpdate and OnComplete or OnStop are called straight
+	// after Updater.Start, Updater.Update and Updater.End respectively.
+	// OnRepeat is called before the Updater.Update of the first frame of each
+	// new repetition. OnComplete and OnStop are called before Done is closed.
+	OnStart    func(info StartInfo) // OnStart is called when the tween starts.
+	OnUpdate   func(frame Frame)    // OnUpdate is called for every frame.
+	OnRepeat   func(frame Frame)    // OnRepeat is called when the tween begins a new repetition.
+	OnComplete func()               // OnComplete is called when the tween runs to its end.
+	OnStop     func(result Result)  // OnStop is called when the tween is stopped or cancelled.
+
+	mu      sync.Mutex    // mu guards the state of the tween below
+	state   State         // state is the current state of the tween
+	manual  bool          // True if the tween is driven by Step rather than a ticker
+	elapsed time.Duration // elapsed is the running time of the tween, excluding pauses
+	last    time.Time     // last is the time elapsed was last brought up to date
+	done    chan struct{} // Internal channel used to terminate the tween early
+	mode    StopMode      // mode is how the tween was asked to stop
+	seek    chan struct{} // Internal channel used to send a frame after a Seek
+	outcome *outcome      // outcome of the current (or next) run of the tween
+	scale   float64       // scale is the time scale set by SetTimeScale
+	scaled  bool          // True if the time scale has been set (it is 1 otherwise)
+	stats   Stats         // stats of the current (or last) run of the tween
+	jitter  time.Duration // jitter is the total absolute jitter of the run
 
-	running bool     // True if the tween is running
-	done    chan int // Internal channel used to terminate the tween early
+	// iteration, index and position are the repetition, index and elapsed
+	// time of the last frame sent, only used by the goroutine driving the
+	// tween
+	iteration int
+	index     int
+	position  time.Duration
+}
+
+// outcome records how a single run of a tween ended.
+type outcome struct {
+	result   Result        // result tells how the run ended, or Pending
+	finished chan struct{} // finished is closed once the run has ended
 }
 
 // Start begins the tween running.
 func (e *Engine) Start() {
-	e.done = make(chan int)
+	e.StartContext(context.Background())
+}
+
+// StartContext begins the tween running like Start, and cancels the tween when
+// ctx is done. A cancelled tween ends just like a stopped one.
+func (e *Engine) StartContext(ctx context.Context) {
+	prev, ok := e.begin(false)
+	if !ok {
+		return
+	}
+	done, seek := e.done, e.seek
+
 	// can't stop this thread unless you call Stop() or let the timer
 	// run out
 	go func() {
-		// Setup internal done channel
-		e.done = make(chan int)
+		if prev != nil {
+			// the previous run may still be sending its final frame and
+			// End, e.g. when restarted as soon as it was Finished
+			<-prev.finished
+		}
 
 		// Based on fps we can calculate how long a frame is:
-		frameDuration := time.Second / time.Duration(e.Framerate) // The duration in a frame
-		cutoff := e.Duration - frameDuration                      // The cutoff point where elapsed time is considered "done"
-		frames := int(e.Duration / frameDuration)                 // The number of frames in the duration
-
-		// start ticker
-		e.running = true
-		e.Updater.Start(e.Framerate, frames, frameDuration, e.Duration)
+		frameDuration := e.frameDuration() // The duration in a frame
+		total, finite := e.runningTime()   // The running time over all repetitions
 
-		// Send initial frame
-		frame := Frame{}
-		e.Updater.Update(frame)
+		// start ticker - after taking the time, so that no tick falls just
+		// before the frame time it is due at
+		prev := e.clock().Now() // prev is the time of the previous tick
+		ticker := e.clock().NewTicker(frameDuration)
+		timeChan := ticker.C()
+		e.announce()
 
-		// set start time
-		ticker := time.NewTicker(frameDuration)
-		timeChan := ticker.C
-		started := time.Now()
+		// the time starts once announced, but at the last tick before so that
+		// the ticks stay on the frame times
+		e.mu.Lock()
+		e.last = e.last.Add(-(e.last.Sub(prev) % frameDuration))
+		e.mu.Unlock()
 
-		for e.running {
+		result := Pending
+		for result == Pending {
 			select {
-			case <-timeChan:
-				frame.Elapsed = time.Since(started)
-
-				// Calculate the frame index - some frames can be skipped so
-				// must find correct time slot for this elapsed time
-				frame.Index = int(frame.Elapsed / frameDuration)
-
-				// Calculate the completed percentage of time
-				frame.Completed = ((float64(frame.Index) * float64(frameDuration)) / float64(e.Duration))
-				if frame.Completed > 1 {
-					go e.Stop()
+			case now := <-timeChan:
+				elapsed, next, ok := e.tick(now, frameDuration)
+				interval := now.Sub(prev)
+				prev = now
+				if !ok {
+					// paused - the updater sees no frames until resumed
+					continue
+				}
+				if finite && elapsed >= total {
+					// the final frame is sent during cleanup
+					result = Completed
 					break
 				}
+				// Update the value - unless the tick falls in the frame
+				// last sent, e.g. after a pause or at a slow time scale
+				if e.frameIndex(elapsed) != e.index || e.CatchUp == CatchUpInterpolate {
+					e.play(elapsed, interval, frameDuration)
+				}
 
-				// Calulate the completed percentage of the transition
-				frame.Transitioned = e.Transition(frame.Completed)
-
-				// Update the value
-				e.Updater.Update(frame)
-
-				// see if we should keep going
-				if frame.Elapsed > cutoff {
-					go e.Stop() // terminate ourself
+				// see if we should keep going - the next tick would be
+				// past the end
+				if finite && next > total {
+					result = Completed // terminate ourself
+				}
+			case <-seek:
+				// Send the seeked frame straight away, even when paused
+				e.mu.Lock()
+				elapsed := e.elapsed
+				e.mu.Unlock()
+				if finite && elapsed >= total {
+					// the final frame is sent during cleanup
+					result = Completed
+					break
 				}
-			case <-e.done:
-				ticker.Stop()
-				e.running = false
+				e.update(e.frameAt(elapsed))
+			case <-done:
+				result = Stopped
+			case <-ctx.Done():
+				result = Cancelled
 			}
 		}
 
 		// cleanup
-		frame.Elapsed = e.Duration
-		frame.Completed = 1
-		frame.Transitioned = 1
-		frame.Index = frames
-		e.Updater.Update(frame)
-		e.Updater.End()
+		ticker.Stop()
+		e.mu.Lock()
+		mode := e.mode
+		stopped, o := e.finish(result)
+		e.mu.Unlock()
+		switch result {
+		case Completed:
+			mode = StopEnd
+		case Cancelled:
+			mode = e.StopMode
+		}
+		e.end(e.stopFrame(mode, stopped), o)
 	}()
 }
 
-// Stop terminates the tween immediately.
+// Step advances a manually driven tween by dt at its time scale and sends the
+// resulting frame to the Updater on the calling goroutine. No goroutine or
+// ticker is created, so Step suits game loops that already run their own
+// fixed-step loop.
+//
+// The first Step starts the tween, sending Start and the initial frame before
+// advancing. Like a tick of a started tween, a Step that stays within the
+// frame last sent sends no frame. The Step that reaches the end of the tween
+// sends the final frame and End and returns false; stepping again restarts
+// the tween. Steps are ignored while the tween is paused. A tween must be
+// driven either by Start or by Step, not both: Step is ignored while a started
+// tween is running.
+func (e *Engine) Step(dt time.Duration) bool {
+	return e.step(dt, true)
+}
+
+// step advances a manually driven tween by dt like Step. If start is false,
+// a tween that is not running is left alone instead of being (re)started.
+func (e *Engine) step(dt time.Duration, start bool) bool {
+	if start {
+		if _, ok := e.begin(true); ok {
+			e.announce()
+		}
+	}
+
+	frameDuration := e.frameDuration()
+	total, finite := e.runningTime()
+	e.mu.Lock()
+	if !e.manual || e.state != Running {
+		defer e.mu.Unlock()
+		return e.state == Running || e.state == Paused
+	}
+	e.advance(dt)
+	elapsed := e.elapsed
+	if finite && elapsed >= total {
+		_, o := e.finish(Completed)
+		e.mu.Unlock()
+		e.end(e.finalFrame(elapsed), o)
+		return false
+	}
+	e.mu.Unlock()
+
+	// Update the value - unless the step falls in the frame last sent, e.g.
+	// the initial frame when started with no time to advance
+	if e.frameIndex(elapsed) != e.index || (e.CatchUp == CatchUpInterpolate && elapsed != e.position) {
+		e.play(elapsed, dt, frameDuration)
+	}
+	return true
+}
+
+// Render runs the whole tween synchronously without waiting on wall-clock
+// time. The Updater receives Start, every frame of the tween with exact
+// Elapsed values, and End before Render returns, which is useful for offline
+// rendering (e.g. exporting a video frame sequence) and for tests.
+//
+// Render must not be called while the tween is running. A tween that repeats
+// forever is only rendered through its first repetition.
+func (e *Engine) Render() {
+	info := e.startInfo()
+	final := e.finalFrame(info.RunningTime)
+	if info.RunningTime == Infinite {
+		final = e.lastFrame(0, e.Delay+e.Duration)
+		info.RunningTime = final.Elapsed
+		info.Frames = final.Index
+	}
+
+	e.start(info)
+	for index := 0; index < info.Frames; index++ {
+		e.update(e.frameAt(e.frameTime(index)))
+	}
+	e.update(final)
+	e.stop(Completed)
+}
+
+// Stop terminates the tween immediately, ending it as set by StopMode. Stop is
+// ignored unless the tween is running or paused.
 func (e *Engine) Stop() {
-	if e.running == true {
-		close(e.done)
+	e.StopWith(e.StopMode)
+}
+
+// StopWith terminates the tween immediately like Stop, ending it as set by
+// mode rather than by StopMode.
+func (e *Engine) StopWith(mode StopMode) {
+	e.mu.Lock()
+	if e.state != Running && e.state != Paused {
+		e.mu.Unlock()
+		return
+	}
+	if !e.manual {
+		select {
+		case <-e.done: // already stopping
+		default:
+			e.mode = mode
+			close(e.done)
+		}
+		e.mu.Unlock()
+		return
+	}
+
+	// there is no goroutine to clean up after a stepped tween
+	stopped, o := e.finish(Stopped)
+	e.mu.Unlock()
+	e.end(e.stopFrame(mode, stopped), o)
+}
+
+// State returns the current state of the tween.
+func (e *Engine) State() State {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.state
+}
+
+// Done returns a channel that is closed when the tween ends, after End has
+// been sent to the Updater. If the tween has ended and is started again, Done
+// returns a new channel for the new run.
+func (e *Engine) Done() <-chan struct{} {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.current().finished
+}
+
+// Wait blocks until the tween ends and returns how it ended.
+func (e *Engine) Wait() Result {
+	e.mu.Lock()
+	o := e.current()
+	e.mu.Unlock()
+
+	<-o.finished
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return o.result
+}
+
+// Result returns how the tween ended, or Pending if it has not ended yet.
+func (e *Engine) Result() Result {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.current().result
+}
+
+// Pause freezes a running tween. No frames are sent to the Updater until the
+// tween is resumed, and the time spent paused does not count towards the
+// elapsed time of the tween.
+func (e *Engine) Pause() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.state != Running {
+		return
+	}
+	if !e.manual && !e.last.IsZero() {
+		// a stepped tween only moves on by Step, and a started one once it
+		// has announced itself
+		now := e.clock().Now()
+		e.advance(now.Sub(e.last))
+		e.last = now
+	}
+	e.state = Paused
+}
+
+// Resume continues a paused tween from where it was paused.
+func (e *Engine) Resume() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.state != Paused {
+		return
+	}
+	e.last = e.clock().Now()
+	e.state = Running
+}
+
+// Stats returns the stats of the current run of the tween, or of the last run
+// if it is not running.
+func (e *Engine) Stats() Stats {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	stats := e.stats
+	if stats.Frames > 0 {
+		stats.Jitter = e.jitter / time.Duration(stats.Frames)
+	}
+	return stats
+}
+
+// SetTimeScale sets how fast the tween plays: 1 is normal speed (the
+// default), 0.5 is half speed, 0 freezes the tween and a negative scale plays
+// it backwards. The time scale can be changed at any time, and the tween
+// carries on from where it is. A tween playing backwards holds its start value
+// once it gets there.
+func (e *Engine) SetTimeScale(scale float64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.state == Running && !e.manual && !e.last.IsZero() {
+		// the time up to now passed at the old scale
+		now := e.clock().Now()
+		e.advance(now.Sub(e.last))
+		e.last = now
+	}
+	e.scale, e.scaled = scale, true
+}
+
+// TimeScale returns the time scale of the tween.
+func (e *Engine) TimeScale() float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.timeScale()
+}
+
+// IsPaused returns true if the tween is currently paused.
+func (e *Engine) IsPaused() bool {
+	return e.State() == Paused
+}
+
+// Seek moves the tween to the elapsed time d, clamped between zero and the
+// running time over all repetitions, and sends the frame for that time to the
+// Updater straight away. A paused tween stays paused at the new position. If
+// the tween is not running, it will begin from d the next time it is started.
+func (e *Engine) Seek(d time.Duration) {
+	if total, finite := e.runningTime(); d < 0 {
+		d = 0
+	} else if finite && d > total {
+		d = total
+	}
+	e.mu.Lock()
+	e.elapsed = d
+	e.last = e.clock().Now()
+	running := e.state == Running || e.state == Paused
+	manual := e.manual
+	if running && !manual {
+		select {
+		case e.seek <- struct{}{}:
+		default: // a seek is already pending and will pick up d
+		}
+	}
+	e.mu.Unlock()
+	if running && manual {
+		e.update(e.frameAt(d))
+	}
+}
+
+// SeekProgress moves the tween to the completion percentage p (0.0 - 1.0) of
+// its running time over all repetitions, or of a single repetition if it
+// repeats forever. See Seek.
+func (e *Engine) SeekProgress(p float64) {
+	total, finite := e.runningTime()
+	if !finite {
+		total = e.Duration
+	}
+	e.Seek(time.Duration(p * float64(total)))
+}
+
+// begin moves an idle or finished tween to the Running state, ready for a new
+// run. It returns false if the tween is already running or paused, and the
+// outcome of the previous run (if any) otherwise, which is released once that
+// run has ended.
+func (e *Engine) begin(manual bool) (prev *outcome, ok bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.state == Running || e.state == Paused {
+		return nil, false
+	}
+	if e.outcome == nil || e.outcome.result != Pending {
+		prev = e.outcome
+		e.outcome = &outcome{finished: make(chan struct{})}
+	}
+	e.state = Running
+	e.manual = manual
+	e.last = time.Time{} // the time starts once the tween is announced
+	e.stats, e.jitter = Stats{}, 0
+	e.done = make(chan struct{})
+	e.seek = make(chan struct{}, 1)
+	return prev, true
+}
+
+// announce sends Start and the initial frame to the Updater. The tween starts
+// where it was seeked to, if at all, and its time starts once the initial
+// frame has been sent, so the time spent in Start doesn't count.
+func (e *Engine) announce() {
+	e.mu.Lock()
+	elapsed := e.elapsed
+	e.mu.Unlock()
+
+	e.start(e.startInfo())
+	e.update(e.frameAt(elapsed))
+
+	e.mu.Lock()
+	e.last = e.clock().Now()
+	e.mu.Unlock()
+}
+
+// start sends Start to the Updater and calls OnStart.
+func (e *Engine) start(info StartInfo) {
+	e.iteration, e.index, e.position = 0, 0, -1
+	e.Updater.Start(info)
+	if e.OnStart != nil {
+		e.OnStart(info)
+	}
+}
+
+// update sends a frame to the Updater and calls OnUpdate, calling OnRepeat
+// first if the frame begins a new repetition. The Delta of the frame is set
+// from the previous frame sent, the first frame of a run has none.
+func (e *Engine) update(frame Frame) {
+	if frame.Iteration > e.iteration && e.OnRepeat != nil {
+		e.OnRepeat(frame)
+	}
+	if e.position >= 0 {
+		frame.Delta = frame.Elapsed - e.position
+	}
+	e.iteration, e.index, e.position = frame.Iteration, frame.Index, frame.Elapsed
+	e.Updater.Update(frame)
+	if e.OnUpdate != nil {
+		e.OnUpdate(frame)
+	}
+}
+
+// play sends the frames for the elapsed time of the tween, reached interval
+// after the previous frame, as set by CatchUp. A zero interval has no jitter.
+func (e *Engine) play(elapsed, interval, frameDuration time.Duration) {
+	var jitter time.Duration
+	if interval != 0 {
+		jitter = interval - frameDuration
+	}
+	index := e.frameIndex(elapsed)
+	dropped := 0
+	if index > e.index+1 {
+		dropped = index - e.index - 1
+	}
+
+	frames := 1
+	if e.CatchUp == CatchUpEvery {
+		for i := e.index + 1; i < index; i++ {
+			e.update(e.frameAt(e.frameTime(i)))
+		}
+		frames += dropped
+		dropped = 0
+	}
+	frame := e.frameAt(elapsed)
+	if e.CatchUp == CatchUpInterpolate {
+		frame = e.frameFor(elapsed, elapsed)
+	}
+	frame.Dropped = dropped
+	frame.Jitter = jitter
+
+	e.mu.Lock()
+	if jitter < 0 {
+		jitter = -jitter
+	}
+	stats := &e.stats
+	e.jitter += jitter
+	stats.Frames += frames
+	stats.Dropped += dropped
+	if jitter > stats.MaxJitter {
+		stats.MaxJitter = jitter
+	}
+	e.mu.Unlock()
+
+	e.update(frame)
+}
+
+// stop sends End to the Updater and calls OnComplete or OnStop for result.
+func (e *Engine) stop(result Result) {
+	e.Updater.End()
+	if result == Completed {
+		if e.OnComplete != nil {
+			e.OnComplete()
+		}
+	} else if e.OnStop != nil {
+		e.OnStop(result)
+	}
+}
+
+// finish moves the tween to the Finished state with result. It returns the
+// elapsed time the tween ended at, and the outcome of the run to release once
+// the Updater has ended. e.mu must be held.
+func (e *Engine) finish(result Result) (time.Duration, *outcome) {
+	elapsed := e.elapsed
+	o := e.current()
+	o.result = result
+	e.state = Finished
+	e.manual = false
+	e.elapsed = 0
+	return elapsed, o
+}
+
+// end sends the final frame and End to the Updater, then releases anyone
+// waiting on the outcome of the run. The tween is already Finished, so it may
+// be restarted from End: a started tween waits for the outcome to be released
+// before it sends Start.
+func (e *Engine) end(frame Frame, o *outcome) {
+	e.update(frame)
+	e.stop(o.result)
+	close(o.finished)
+}
+
+// current returns the outcome of the current (or next) run of the tween.
+// e.mu must be held.
+func (e *Engine) current() *outcome {
+	if e.outcome == nil {
+		e.outcome = &outcome{finished: make(chan struct{})}
+	}
+	return e.outcome
+}
+
+// frameDuration returns the duration of a single frame at the tween framerate,
+// rounded up to the nanosecond so that a tick never comes before its frame.
+func (e *Engine) frameDuration() time.Duration {
+	return e.frameTime(1)
+}
+
+// frameTime returns the elapsed time of the frame index: the first nanosecond
+// at or after index frames at the tween framerate.
+func (e *Engine) frameTime(index int) time.Duration {
+	return e.Framerate.frameTime(index)
+}
+
+// frameIndex returns the index of the frame the elapsed time falls in.
+func (e *Engine) frameIndex(elapsed time.Duration) int {
+	return e.Framerate.frameIndex(elapsed)
+}
+
+// frameCount returns the number of frames before the elapsed time, which is
+// also the index of a final frame at that time.
+func (e *Engine) frameCount(elapsed time.Duration) int {
+	if elapsed <= 0 {
+		return 0
+	}
+	return e.frameIndex(elapsed-1) + 1
+}
+
+// startInfo describes the tween for Updater.Start.
+func (e *Engine) startInfo() StartInfo {
+	info := StartInfo{
+		Framerate: e.Framerate,
+		FrameTime: e.frameDuration(),
+		Delay:     e.Delay,
+		Hold:      e.Hold,
+	}
+	total, finite := e.runningTime()
+	info.RunningTime = total
+	info.Frames = e.frameCount(total) // The number of frames in the running time
+	if !finite {
+		info.Frames = Infinite
+	}
+	return info
+}
+
+// runningTime returns the running time of the tween over all repetitions,
+// including Delay and Hold. finite is false if the tween repeats forever.
+func (e *Engine) runningTime() (total time.Duration, finite bool) {
+	if e.Repeat == Infinite {
+		return Infinite, false
+	}
+	return e.Delay + e.Duration*time.Duration(e.Repeat+1) + e.Hold, true
+}
+
+// reversed returns true if the repetition iteration plays from end to start.
+func (e *Engine) reversed(iteration int) bool {
+	return e.Reverse != (e.Yoyo && iteration%2 == 1)
+}
+
+// frameAt calculates the frame for the elapsed time of the tween. Frames at
+// or beyond the running time are the final frame of the tween. The start
+// value is sent during the Delay and the end value during the Hold.
+func (e *Engine) frameAt(elapsed time.Duration) Frame {
+	// Some frames can be skipped so must find correct time slot for this
+	// elapsed time
+	return e.frameFor(elapsed, e.frameTime(e.frameIndex(elapsed)))
+}
+
+// frameFor calculates the frame for the elapsed time of the tween, with the
+// transition calculated at the time slot of the frame.
+func (e *Engine) frameFor(elapsed, slot time.Duration) Frame {
+	if total, finite := e.runningTime(); finite && elapsed >= total {
+		return e.finalFrame(elapsed)
+	}
+	frame := Frame{Elapsed: elapsed}
+
+	// Calculate the frame index
+	frame.Index = e.frameIndex(elapsed)
+
+	if e.Duration <= 0 {
+		// a tween without a duration is already at its end value
+		iteration := e.Repeat
+		if iteration == Infinite {
+			iteration = 0
+		}
+		return e.endFrame(iteration, elapsed)
+	}
+
+	// Find the time into the repetitions the frame time slot falls at
+	slot -= e.Delay
+	delayed := slot < 0
+	if delayed {
+		slot = 0 // still delayed
+	} else if e.Repeat != Infinite && slot >= e.Duration*time.Duration(e.Repeat+1) {
+		// holding the end value
+		return e.endFrame(e.Repeat, elapsed)
+	}
+
+	// Find the repetition the frame time slot falls in
+	frame.Iteration = int(slot / e.Duration)
+	frame.Reversed = e.reversed(frame.Iteration)
+
+	// Calculate the completed percentage of time
+	frame.Completed = float64(slot-time.Duration(frame.Iteration)*e.Duration) / float64(e.Duration)
+	if frame.Reversed {
+		frame.Completed = 1 - frame.Completed
+	}
+
+	// Calulate the completed percentage of the transition
+	frame.Transitioned = e.Transition(frame.Completed)
+	if !delayed {
+		frame.Velocity = e.velocity(frame.Completed, frame.Reversed)
+	}
+	return frame
+}
+
+// velocity returns the rate of change of the transition per second at the
+// completed percentage of a repetition, which is negative if the repetition
+// plays from end to start and the transition is increasing.
+func (e *Engine) velocity(completed float64, reversed bool) float64 {
+	const h = 1e-6 // the step of the central difference
+	lo, hi := math.Max(completed-h, 0), math.Min(completed+h, 1)
+	velocity := (e.Transition(hi) - e.Transition(lo)) / (hi - lo) / e.Duration.Seconds()
+	if reversed {
+		velocity = -velocity
+	}
+	return velocity
+}
+
+// stopFrame returns the frame sent when the tween is stopped at the elapsed
+// time with mode.
+func (e *Engine) stopFrame(mode StopMode, elapsed time.Duration) Frame {
+	switch mode {
+	case StopFreeze:
+		return e.frameAt(elapsed)
+	case StopRevert:
+		return e.frameAt(0)
+	}
+	return e.finalFrame(elapsed)
+}
+
+// finalFrame returns the frame sent when the tween ends at the elapsed time.
+// A tween that repeats forever ends with its current repetition.
+func (e *Engine) finalFrame(elapsed time.Duration) Frame {
+	if total, finite := e.runningTime(); finite {
+		return e.lastFrame(e.Repeat, total)
+	}
+	iteration := 0
+	if elapsed > e.Delay && e.Duration > 0 {
+		iteration = int((elapsed - e.Delay) / e.Duration)
+	}
+	return e.lastFrame(iteration, e.Delay+e.Duration*time.Duration(iteration+1))
+}
+
+// lastFrame returns the final frame of a tween ending with the repetition
+// iteration at the elapsed time. Its index follows on from the index of the
+// frame before it, even if the elapsed time falls between frames.
+func (e *Engine) lastFrame(iteration int, elapsed time.Duration) Frame {
+	frame := e.endFrame(iteration, elapsed)
+	frame.Index = e.frameCount(elapsed)
+	return frame
+}
+
+// endFrame returns the frame with the end value of the repetition iteration
+// at the elapsed time.
+func (e *Engine) endFrame(iteration int, elapsed time.Duration) Frame {
+	frame := Frame{
+		Completed:    1,
+		Transitioned: 1,
+		Index:        e.frameIndex(elapsed),
+		Elapsed:      elapsed,
+		Iteration:    iteration,
+		Reversed:     e.reversed(iteration),
+	}
+	if frame.Reversed {
+		frame.Completed = 0
+		frame.Transitioned = 0
+	}
+	return frame
+}
+
+// clock returns the Clock of the tween, or SystemClock if it has none.
+func (e *Engine) clock() Clock {
+	if e.Clock == nil {
+		return SystemClock
+	}
+	return e.Clock
+}
+
+// tick brings the elapsed time up to the time now of a tick and returns it,
+// along with the elapsed time a frameDuration later at the current time scale.
+// ok is false if the tween is paused, in which case the elapsed time is left
+// unchanged.
+func (e *Engine) tick(now time.Time, frameDuration time.Duration) (elapsed, next time.Duration, ok bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.state != Running {
+		return e.elapsed, e.elapsed, false
+	}
+	if now.Before(e.last) {
+		// a tick held by the ticker from before the time started, e.g.
+		// while the Updater was starting or the tween was paused
+		now = e.last
+	}
+	e.advance(now.Sub(e.last))
+	e.last = now
+	return e.elapsed, e.elapsed + scale(frameDuration, e.timeScale()), true
+}
+
+// advance moves the elapsed time on by the time d at the time scale of the
+// tween, stopping at the start of the tween. e.mu must be held.
+func (e *Engine) advance(d time.Duration) {
+	e.elapsed += scale(d, e.timeScale())
+	if e.elapsed < 0 {
+		e.elapsed = 0
+	}
+}
+
+// timeScale returns the time scale of the tween. e.mu must be held.
+func (e *Engine) timeScale() float64 {
+	if !e.scaled {
+		return 1
+	}
+	return e.scale
+}
+
+// scale returns the time d scaled by s.
+func scale(d time.Duration, s float64) time.Duration {
+	if s == 1 {
+		return d
 	}
+	return time.Duration(float64(d) * s)
 }
@@ -1,6 +1,10 @@
 package tween_test
 
 import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
 	"time"
 
 	. "github.com/draoncc/tween"
@@ -11,49 +15,934 @@ import (
 )
 
 type Recorder struct {
+	sync.Mutex
+	Starts      int
+	Info        StartInfo
 	Frames      []Frame
-	FPS         int
+	FPS         Framerate
 	TotalFrames int
 	FTime       time.Duration
 	Running     time.Duration
 	Done        chan int
 }
 
-func (u *Recorder) Start(framerate, frames int, frameTime, runningTime time.Duration) {
-	u.FPS = framerate
-	u.TotalFrames = frames
-	u.FTime = frameTime
-	u.Running = runningTime
+func (u *Recorder) Start(info StartInfo) {
+	u.Lock()
+	defer u.Unlock()
+	u.Starts++
+	u.Info = info
+	u.FPS = info.Framerate
+	u.TotalFrames = info.Frames
+	u.FTime = info.FrameTime
+	u.Running = info.RunningTime
 }
 
 func (u *Recorder) Update(frame Frame) {
+	u.Lock()
+	defer u.Unlock()
 	u.Frames = append(u.Frames, frame)
 }
 
+// Last returns the most recently recorded frame.
+func (u *Recorder) Last() Frame {
+	u.Lock()
+	defer u.Unlock()
+	if len(u.Frames) == 0 {
+		return Frame{}
+	}
+	return u.Frames[len(u.Frames)-1]
+}
+
+// Count returns the number of frames recorded so far.
+func (u *Recorder) Count() int {
+	u.Lock()
+	defer u.Unlock()
+	return len(u.Frames)
+}
+
 func (u *Recorder) End() {
 	u.Done <- 1
 }
 
+// drive advances the clock until the tween signals done.
+func drive(clock *FakeClock, done chan int) {
+	for {
+		select {
+		case <-done:
+			return
+		default:
+			clock.Advance(time.Millisecond)
+		}
+	}
+}
+
 var _ = Describe("Core", func() {
 	Describe("Engine", func() {
 		It("should generate frames", func(done Done) {
 			d := make(chan int)
 			recorder := &Recorder{Done: d}
+			clock := NewFakeClock(time.Now())
 			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Clock = clock
 			engine.Start()
-			<-d
-			Ω(recorder.FPS).Should(Equal(60))
+			Eventually(recorder.Count).Should(Equal(1))
+			drive(clock, d)
+			Ω(recorder.FPS).Should(Equal(FPS(60)))
 			Ω(recorder.TotalFrames).Should(Equal(60))
-			Ω(recorder.FTime).Should(Equal(16666666 * time.Nanosecond))
+			Ω(recorder.FTime).Should(Equal(16666667 * time.Nanosecond))
 			Ω(recorder.Running).Should(Equal(time.Second))
+			for i, frame := range recorder.Frames[:60] {
+				Ω(frame.Index).Should(Equal(i))
+				Ω(frame.Elapsed).Should(Equal(time.Duration(i) * recorder.FTime))
+			}
 			last := recorder.Frames[len(recorder.Frames)-1]
 			Ω(last.Index).Should(Equal(60))
 			Ω(last.Completed).Should(Equal(1.))
 			Ω(last.Transitioned).Should(Equal(1.))
 			Ω(last.Elapsed).Should(Equal(time.Second))
-			//Ω(recorder.Frames).Should(HaveLen(61))
-			//Ω(recorder.Frames).Should(Equal([]Frame{}))
+			Ω(recorder.Frames).Should(HaveLen(61))
+			close(done)
+		}, 2)
+		It("should pause and resume", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(200*time.Millisecond, easing.Linear, recorder)
+			engine.Clock = clock
+			engine.Start()
+			Eventually(recorder.Count).Should(Equal(1))
+			clock.Advance(51 * time.Millisecond)
+			Eventually(recorder.Count).Should(Equal(4))
+			engine.Pause()
+			Ω(engine.IsPaused()).Should(BeTrue())
+			clock.Advance(time.Second)
+			Ω(recorder.Count()).Should(Equal(4))
+			engine.Resume()
+			Ω(engine.IsPaused()).Should(BeFalse())
+			drive(clock, d)
+			for i := 1; i < len(recorder.Frames); i++ {
+				// the tween resumes part way into a frame, so the frame
+				// after the pause may come up to two frame times later
+				step := recorder.Frames[i].Elapsed - recorder.Frames[i-1].Elapsed
+				Ω(step).Should(BeNumerically("<", 2*recorder.FTime))
+				Ω(recorder.Frames[i].Index).Should(BeNumerically(">", recorder.Frames[i-1].Index))
+			}
+			close(done)
+		}, 2)
+		It("should seek while paused", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Clock = clock
+			engine.Start()
+			Eventually(recorder.Count).Should(Equal(1))
+			clock.Advance(51 * time.Millisecond)
+			Eventually(recorder.Count).Should(Equal(4))
+			engine.Pause()
+			engine.SeekProgress(.5)
+			Eventually(recorder.Last).Should(Equal(Frame{
+				Completed:    .5,
+				Transitioned: .5,
+				Index:        30,
+				Elapsed:      500 * time.Millisecond,
+				Delta:        449999999,
+				Velocity:     1,
+			}))
+			Ω(engine.IsPaused()).Should(BeTrue())
+			engine.Seek(time.Second)
+			Eventually(recorder.Last).Should(Equal(Frame{
+				Completed:    1,
+				Transitioned: 1,
+				Index:        60,
+				Elapsed:      time.Second,
+				Delta:        500 * time.Millisecond,
+			}))
+			engine.Resume()
+			drive(clock, d)
+			close(done)
+		}, 2)
+		It("should not count the time spent starting", func(done Done) {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			start := time.Now()
+			clock := NewFakeClock(start)
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Clock = clock
+			advanced := make(chan struct{})
+			engine.OnStart = func(StartInfo) {
+				// the tween takes the first frame time to start
+				go func() {
+					clock.Advance(300 * time.Millisecond)
+					close(advanced)
+				}()
+				for clock.Now().Equal(start) {
+					runtime.Gosched()
+				}
+			}
+			engine.Start()
+			<-advanced
+			Eventually(func() int { return recorder.Last().Index }).Should(Equal(16))
+			engine.Stop()
+			engine.Wait()
+			close(done)
+		}, 2)
+		It("should complete when seeked to the end", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Clock = clock
+			engine.Start()
+			Eventually(recorder.Count).Should(Equal(1))
+			engine.Seek(time.Second)
+			drive(clock, d)
+			Ω(engine.Wait()).Should(Equal(Completed))
+			Ω(recorder.Frames).Should(HaveLen(2))
+			Ω(recorder.Last().Index).Should(Equal(60))
+			close(done)
+		}, 2)
+		It("should start from a seeked position", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Clock = clock
+			engine.Seek(900 * time.Millisecond)
+			engine.Start()
+			Eventually(recorder.Count).Should(Equal(1))
+			drive(clock, d)
+			first := recorder.Frames[0]
+			Ω(first.Index).Should(Equal(54))
+			Ω(first.Elapsed).Should(Equal(900 * time.Millisecond))
+			Ω(len(recorder.Frames)).Should(BeNumerically("<=", 8))
+			close(done)
+		}, 2)
+		It("should repeat and yoyo", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(200*time.Millisecond, easing.Linear, recorder)
+			engine.Repeat = 2
+			engine.Yoyo = true
+			engine.Clock = clock
+			engine.Start()
+			Eventually(recorder.Count).Should(Equal(1))
+			drive(clock, d)
+			Ω(recorder.TotalFrames).Should(Equal(36))
+			Ω(recorder.Running).Should(Equal(600 * time.Millisecond))
+			Ω(recorder.Frames[0].Completed).Should(Equal(0.))
+			iterations := map[int]bool{}
+			for _, frame := range recorder.Frames {
+				iterations[frame.Iteration] = true
+				Ω(frame.Reversed).Should(Equal(frame.Iteration == 1))
+			}
+			Ω(iterations).Should(HaveLen(3))
+			Ω(recorder.Last()).Should(Equal(Frame{
+				Completed:    1,
+				Transitioned: 1,
+				Index:        36,
+				Elapsed:      600 * time.Millisecond,
+				Iteration:    2,
+				Delta:        16666655,
+			}))
+			close(done)
+		}, 2)
+		It("should play in reverse", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(200*time.Millisecond, easing.Linear, recorder)
+			engine.Reverse = true
+			engine.Clock = clock
+			engine.Start()
+			Eventually(recorder.Count).Should(Equal(1))
+			drive(clock, d)
+			Ω(recorder.Frames[0].Completed).Should(Equal(1.))
+			Ω(recorder.Frames[0].Reversed).Should(BeTrue())
+			last := recorder.Last()
+			Ω(last.Completed).Should(Equal(0.))
+			Ω(last.Transitioned).Should(Equal(0.))
+			close(done)
+		}, 2)
+		It("should repeat forever until stopped", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(50*time.Millisecond, easing.Linear, recorder)
+			engine.Repeat = Infinite
+			engine.Clock = clock
+			engine.Start()
+			Eventually(recorder.Count).Should(Equal(1))
+			for recorder.Last().Iteration < 3 {
+				clock.Advance(time.Millisecond)
+			}
+			engine.Stop()
+			<-d
+			Ω(recorder.TotalFrames).Should(Equal(Infinite))
+			last := recorder.Last()
+			Ω(last.Iteration).Should(Equal(3))
+			Ω(last.Completed).Should(Equal(1.))
+			close(done)
+		}, 2)
+	})
+	Describe("Completion", func() {
+		It("should wait for a tween to complete", func(done Done) {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(100*time.Millisecond, easing.Linear, recorder)
+			engine.Clock = clock
+			Ω(engine.Result()).Should(Equal(Pending))
+			finished := engine.Done()
+			engine.Start()
+			go func() {
+				for {
+					select {
+					case <-finished:
+						return
+					default:
+						clock.Advance(time.Millisecond)
+					}
+				}
+			}()
+			Ω(engine.Wait()).Should(Equal(Completed))
+			Ω(recorder.Done).Should(Receive())
+			Ω(finished).Should(BeClosed())
+			close(done)
+		}, 2)
+		It("should report a stopped tween", func(done Done) {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Hour, easing.Linear, recorder)
+			engine.Clock = NewFakeClock(time.Now())
+			engine.Start()
+			Eventually(recorder.Count).Should(Equal(1))
+			engine.Stop()
+			engine.Stop()
+			Ω(engine.Wait()).Should(Equal(Stopped))
+			close(done)
+		}, 2)
+		It("should cancel with its context", func(done Done) {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Hour, easing.Linear, recorder)
+			engine.Clock = NewFakeClock(time.Now())
+			ctx, cancel := context.WithCancel(context.Background())
+			engine.StartContext(ctx)
+			cancel()
+			Eventually(engine.Done()).Should(BeClosed())
+			Ω(engine.Result()).Should(Equal(Cancelled))
+			Ω(recorder.Done).Should(Receive())
+			close(done)
+		}, 2)
+		It("should report a stepped tween", func() {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Step(time.Second)
+			Ω(engine.Done()).Should(BeClosed())
+			Ω(engine.Result()).Should(Equal(Completed))
+			Ω(recorder.Done).Should(Receive())
+			engine.Step(0)
+			Ω(engine.Result()).Should(Equal(Pending))
+			engine.Stop()
+			Ω(engine.Wait()).Should(Equal(Stopped))
+		})
+	})
+	Describe("State", func() {
+		It("should move through its states", func(done Done) {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(100*time.Millisecond, easing.Linear, recorder)
+			engine.Clock = clock
+			Ω(engine.State()).Should(Equal(Idle))
+			engine.Stop()
+			Ω(engine.State()).Should(Equal(Idle))
+			engine.Start()
+			Ω(engine.State()).Should(Equal(Running))
+			engine.Pause()
+			Ω(engine.State()).Should(Equal(Paused))
+			engine.Resume()
+			Ω(engine.State()).Should(Equal(Running))
+			engine.Stop()
+			Ω(engine.Wait()).Should(Equal(Stopped))
+			Ω(engine.State()).Should(Equal(Finished))
+			close(done)
+		}, 2)
+		It("should ignore a second Start", func(done Done) {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Hour, easing.Linear, recorder)
+			engine.Clock = NewFakeClock(time.Now())
+			engine.Start()
+			engine.Start()
+			engine.Stop()
+			engine.Wait()
+			Ω(recorder.Starts).Should(Equal(1))
+			Ω(recorder.Frames).Should(HaveLen(2))
+			close(done)
+		}, 2)
+		It("should stop concurrently", func(done Done) {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Hour, easing.Linear, recorder)
+			engine.Start()
+			stopped := make(chan int)
+			for i := 0; i < 10; i++ {
+				go func() {
+					engine.Stop()
+					stopped <- 1
+				}()
+			}
+			for i := 0; i < 10; i++ {
+				<-stopped
+			}
+			Ω(engine.Wait()).Should(Equal(Stopped))
+			Ω(recorder.Done).Should(Receive())
+			Ω(recorder.Done).ShouldNot(Receive())
+			close(done)
+		}, 2)
+		It("should restart after it has finished", func(done Done) {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(100*time.Millisecond, easing.Linear, recorder)
+			engine.Clock = clock
+			for run := 1; run <= 2; run++ {
+				engine.Start()
+				drive(clock, recorder.Done)
+				Ω(engine.Wait()).Should(Equal(Completed))
+				Ω(recorder.Starts).Should(Equal(run))
+			}
+			Ω(recorder.Frames[0].Elapsed).Should(Equal(time.Duration(0)))
+			Ω(recorder.Frames[len(recorder.Frames)/2].Elapsed).Should(Equal(time.Duration(0)))
+			close(done)
+		}, 2)
+		It("should restart from another goroutine as soon as it has finished", func(done Done) {
+			var mu sync.Mutex
+			events := []string{}
+			record := func(event string) {
+				mu.Lock()
+				defer mu.Unlock()
+				events = append(events, event)
+			}
+			recorded := func() []string {
+				mu.Lock()
+				defer mu.Unlock()
+				return append([]string{}, events...)
+			}
+
+			recorder := &Recorder{Done: make(chan int, 2)}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(100*time.Millisecond, easing.Linear, recorder)
+			engine.Clock = clock
+			restarted := make(chan struct{})
+			runs := 0
+			engine.OnStart = func(StartInfo) { record("start") }
+			engine.OnComplete = func() {
+				if runs++; runs == 1 {
+					// the first run is still ending when restarted
+					<-restarted
+				}
+				record("complete")
+			}
+			engine.Start()
+			go func() {
+				for engine.State() != Finished {
+					runtime.Gosched()
+				}
+				engine.Start()
+				close(restarted)
+			}()
+			Eventually(recorded).Should(HaveLen(1))
+			drive(clock, recorder.Done)
+			Eventually(recorded).Should(HaveLen(3))
+			drive(clock, recorder.Done)
+			Ω(engine.Wait()).Should(Equal(Completed))
+			Ω(recorded()).Should(Equal([]string{"start", "complete", "start", "complete"}))
+			close(done)
+		}, 2)
+		It("should restart from End", func(done Done) {
+			recorder := &Recorder{Done: make(chan int, 2)}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(100*time.Millisecond, easing.Linear, recorder)
+			engine.Clock = clock
+			started := make(chan StartInfo, 2)
+			runs := 0
+			engine.OnStart = func(info StartInfo) { started <- info }
+			engine.OnComplete = func() {
+				if runs++; runs == 1 {
+					engine.Start()
+				}
+			}
+			engine.Start()
+			<-started
+			drive(clock, recorder.Done)
+			<-started
+			drive(clock, recorder.Done)
+			Ω(engine.Wait()).Should(Equal(Completed))
+			Ω(recorder.Starts).Should(Equal(2))
+			close(done)
+		}, 2)
+	})
+	Describe("StopMode", func() {
+		stop := func(mode StopMode) Frame {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Framerate = FPS(4)
+			engine.Clock = clock
+			engine.Start()
+			Eventually(recorder.Count).Should(Equal(1))
+			clock.Advance(500 * time.Millisecond)
+			Eventually(recorder.Count).Should(Equal(3))
+			engine.StopWith(mode)
+			engine.Wait()
+			return recorder.Last()
+		}
+		It("should jump to the end", func(done Done) {
+			last := stop(StopEnd)
+			Ω(last.Completed).Should(Equal(1.))
+			Ω(last.Transitioned).Should(Equal(1.))
+			close(done)
+		}, 2)
+		It("should freeze in place", func(done Done) {
+			last := stop(StopFreeze)
+			Ω(last.Completed).Should(Equal(.5))
+			Ω(last.Elapsed).Should(Equal(500 * time.Millisecond))
+			close(done)
+		}, 2)
+		It("should revert to the start", func(done Done) {
+			last := stop(StopRevert)
+			Ω(last.Completed).Should(Equal(0.))
+			Ω(last.Transitioned).Should(Equal(0.))
+			Ω(last.Index).Should(Equal(0))
+			close(done)
+		}, 2)
+		It("should use the StopMode of the engine", func() {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Framerate = FPS(4)
+			engine.StopMode = StopFreeze
+			engine.Step(250 * time.Millisecond)
+			engine.Stop()
+			Ω(recorder.Last().Completed).Should(Equal(.25))
+		})
+	})
+	Describe("Step", func() {
+		It("should advance on each step", func() {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Framerate = FPS(4)
+			Ω(engine.Step(250 * time.Millisecond)).Should(BeTrue())
+			Ω(recorder.TotalFrames).Should(Equal(4))
+			Ω(recorder.Frames).Should(HaveLen(2))
+			Ω(engine.Step(250 * time.Millisecond)).Should(BeTrue())
+			engine.Pause()
+			Ω(engine.Step(250 * time.Millisecond)).Should(BeTrue())
+			Ω(recorder.Frames).Should(HaveLen(3))
+			engine.Resume()
+			Ω(engine.Step(250 * time.Millisecond)).Should(BeTrue())
+			Ω(recorder.Done).ShouldNot(Receive())
+			Ω(engine.Step(300 * time.Millisecond)).Should(BeFalse())
+			Ω(recorder.Done).Should(Receive())
+			completed := []float64{}
+			for _, frame := range recorder.Frames {
+				completed = append(completed, frame.Completed)
+			}
+			Ω(completed).Should(Equal([]float64{0, .25, .5, .75, 1}))
+		})
+		It("should not move on in wall-clock time when paused", func() {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Framerate = FPS(4)
+			engine.Clock = clock
+			engine.Step(250 * time.Millisecond)
+			clock.Advance(600 * time.Millisecond)
+			engine.Pause()
+			engine.Resume()
+			engine.Step(250 * time.Millisecond)
+			Ω(recorder.Last().Elapsed).Should(Equal(500 * time.Millisecond))
+		})
+		It("should seek and stop on the calling goroutine", func() {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Framerate = FPS(4)
+			engine.Step(0)
+			engine.SeekProgress(.75)
+			Ω(recorder.Last().Completed).Should(Equal(.75))
+			engine.Stop()
+			Ω(recorder.Done).Should(Receive())
+			Ω(recorder.Last().Completed).Should(Equal(1.))
+		})
+	})
+	Describe("Delay and Hold", func() {
+		It("should hold the start and end values", func() {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Framerate = FPS(4)
+			engine.Delay = 500 * time.Millisecond
+			engine.Hold = 250 * time.Millisecond
+			engine.Render()
+			Ω(recorder.Info).Should(Equal(StartInfo{
+				Framerate:   FPS(4),
+				Frames:      7,
+				FrameTime:   250 * time.Millisecond,
+				RunningTime: 1750 * time.Millisecond,
+				Delay:       500 * time.Millisecond,
+				Hold:        250 * time.Millisecond,
+			}))
+			completed := []float64{}
+			for _, frame := range recorder.Frames {
+				completed = append(completed, frame.Completed)
+			}
+			Ω(completed).Should(Equal([]float64{0, 0, 0, .25, .5, .75, 1, 1}))
+			Ω(recorder.Last().Elapsed).Should(Equal(1750 * time.Millisecond))
+		})
+		It("should respect the delay when paused, seeked and stopped", func() {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Framerate = FPS(4)
+			engine.Delay = time.Second
+			engine.Step(500 * time.Millisecond)
+			Ω(recorder.Last().Completed).Should(Equal(0.))
+			engine.Pause()
+			engine.Step(time.Second)
+			engine.Resume()
+			engine.Step(750 * time.Millisecond)
+			Ω(recorder.Last().Completed).Should(Equal(.25))
+			engine.Seek(250 * time.Millisecond)
+			Ω(recorder.Last().Completed).Should(Equal(0.))
+			engine.StopWith(StopFreeze)
+			Ω(recorder.Last().Completed).Should(Equal(0.))
+			Ω(recorder.Last().Elapsed).Should(Equal(250 * time.Millisecond))
+		})
+		It("should end straight away without a duration", func() {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(0, easing.Linear, recorder)
+			engine.Framerate = FPS(4)
+			engine.Delay = 100 * time.Millisecond
+			engine.Render()
+			Ω(recorder.Frames).Should(HaveLen(2))
+			Ω(recorder.Last().Transitioned).Should(Equal(1.))
+			Ω(recorder.Done).Should(Receive())
+			engine.Repeat = Infinite
+			Ω(engine.Step(50 * time.Millisecond)).Should(BeTrue())
+			Ω(engine.Step(100 * time.Millisecond)).Should(BeTrue())
+			Ω(recorder.Last().Transitioned).Should(Equal(1.))
+			engine.Stop()
+			Ω(recorder.Done).Should(Receive())
+			Ω(recorder.Last().Transitioned).Should(Equal(1.))
+		})
+	})
+	Describe("Render", func() {
+		It("should render every frame without waiting", func() {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Hour, easing.Linear, recorder)
+			engine.Framerate = FPS(1)
+			engine.Render()
+			Ω(recorder.Done).Should(Receive())
+			Ω(recorder.TotalFrames).Should(Equal(3600))
+			Ω(recorder.Frames).Should(HaveLen(3601))
+			for i, frame := range recorder.Frames {
+				Ω(frame.Index).Should(Equal(i))
+				Ω(frame.Elapsed).Should(Equal(time.Duration(i) * time.Second))
+			}
+			Ω(recorder.Last().Completed).Should(Equal(1.))
+		})
+		It("should render repetitions", func() {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Framerate = FPS(4)
+			engine.Repeat = 1
+			engine.Yoyo = true
+			engine.Render()
+			completed := []float64{}
+			for _, frame := range recorder.Frames {
+				completed = append(completed, frame.Completed)
+			}
+			Ω(completed).Should(Equal([]float64{0, .25, .5, .75, 1, .75, .5, .25, 0}))
+		})
+	})
+	Describe("TimeScale", func() {
+		It("should scale the steps", func() {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Framerate = FPS(4)
+			Ω(engine.TimeScale()).Should(Equal(1.))
+			engine.SetTimeScale(.5)
+			engine.Step(500 * time.Millisecond)
+			engine.Step(500 * time.Millisecond)
+			engine.SetTimeScale(0)
+			engine.Step(500 * time.Millisecond)
+			engine.SetTimeScale(-1)
+			engine.Step(250 * time.Millisecond)
+			engine.Step(time.Second)
+			engine.SetTimeScale(2)
+			engine.Step(250 * time.Millisecond)
+			Ω(completed(recorder)).Should(Equal([]float64{0, .25, .5, .25, 0, .5}))
+		})
+		It("should change speed without jumping", func(done Done) {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Framerate = FPS(4)
+			engine.Clock = clock
+			engine.CatchUp = CatchUpInterpolate
+			engine.Start()
+			Eventually(recorder.Count).Should(Equal(1))
+			clock.Advance(500 * time.Millisecond)
+			Eventually(recorder.Count).Should(Equal(3))
+			clock.Advance(100 * time.Millisecond)
+			engine.SetTimeScale(.1)
+			clock.Advance(150 * time.Millisecond)
+			Eventually(recorder.Count).Should(Equal(4))
+			// 100ms at full speed and 150ms at a tenth of the speed
+			Ω(recorder.Last().Elapsed).Should(Equal(615 * time.Millisecond))
+			Ω(recorder.Last().Completed).Should(BeNumerically("~", .615, 1e-9))
+			engine.SetTimeScale(-2)
+			clock.Advance(250 * time.Millisecond)
+			Eventually(recorder.Count).Should(Equal(5))
+			Ω(recorder.Last().Elapsed).Should(Equal(115 * time.Millisecond))
+			engine.Stop()
+			Eventually(recorder.Done).Should(Receive())
+			close(done)
+		}, 2)
+	})
+	Describe("Schedule", func() {
+		schedules := []struct {
+			duration  time.Duration
+			framerate int
+		}{
+			{time.Second, 60},
+			{time.Second, 7},
+			{1100 * time.Millisecond, 4},
+			{333 * time.Millisecond, 60},
+			{10 * time.Millisecond, 24},
+			{2501 * time.Millisecond, 30},
+			{time.Second / 3, 3},
+		}
+		check := func(frames []Frame, duration time.Duration, count int) {
+			Ω(frames).Should(HaveLen(count + 1))
+			Ω(frames[0].Index).Should(Equal(0))
+			Ω(frames[0].Elapsed).Should(BeZero())
+			for i := 1; i < len(frames); i++ {
+				Ω(frames[i].Index).Should(BeNumerically(">", frames[i-1].Index))
+				Ω(frames[i].Completed).Should(BeNumerically("<=", 1))
+			}
+			last := frames[len(frames)-1]
+			Ω(last.Index).Should(Equal(count))
+			Ω(last.Elapsed).Should(Equal(duration))
+			Ω(last.Completed).Should(Equal(1.))
+		}
+		for _, schedule := range schedules {
+			schedule := schedule
+			name := fmt.Sprintf("%v at %dfps", schedule.duration, schedule.framerate)
+			It("should render every frame of "+name, func() {
+				recorder := &Recorder{Done: make(chan int, 1)}
+				engine := NewEngine(schedule.duration, easing.Linear, recorder)
+				engine.Framerate = FPS(schedule.framerate)
+				engine.Render()
+				check(recorder.Frames, schedule.duration, recorder.TotalFrames)
+				for i, frame := range recorder.Frames[:recorder.TotalFrames] {
+					Ω(frame.Index).Should(Equal(i))
+				}
+			})
+			It("should play every frame of "+name, func(done Done) {
+				d := make(chan int)
+				recorder := &Recorder{Done: d}
+				clock := NewFakeClock(time.Now())
+				engine := NewEngine(schedule.duration, easing.Linear, recorder)
+				engine.Framerate = FPS(schedule.framerate)
+				engine.Clock = clock
+				engine.Start()
+				Eventually(recorder.Count).Should(Equal(1))
+				drive(clock, d)
+				check(recorder.Frames, schedule.duration, recorder.TotalFrames)
+				Ω(engine.Stats().Dropped).Should(BeZero())
+				close(done)
+			}, 2)
+		}
+	})
+	Describe("CatchUp", func() {
+		stepped := func(catchUp CatchUp) (*Engine, *Recorder) {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Framerate = FPS(4)
+			engine.CatchUp = catchUp
+			return engine, recorder
+		}
+		It("should skip to the latest frame", func() {
+			engine, recorder := stepped(CatchUpSkip)
+			engine.Step(850 * time.Millisecond)
+			Ω(completed(recorder)).Should(Equal([]float64{0, .75}))
+			Ω(recorder.Last().Dropped).Should(Equal(2))
+			Ω(recorder.Last().Jitter).Should(Equal(600 * time.Millisecond))
+			Ω(engine.Stats()).Should(Equal(Stats{
+				Frames:    1,
+				Dropped:   2,
+				Jitter:    600 * time.Millisecond,
+				MaxJitter: 600 * time.Millisecond,
+			}))
+		})
+		It("should send every missed frame", func() {
+			engine, recorder := stepped(CatchUpEvery)
+			engine.Step(850 * time.Millisecond)
+			Ω(completed(recorder)).Should(Equal([]float64{0, .25, .5, .75}))
+			Ω(recorder.Last().Dropped).Should(Equal(0))
+			Ω(engine.Stats()).Should(Equal(Stats{
+				Frames:    3,
+				Jitter:    200 * time.Millisecond,
+				MaxJitter: 600 * time.Millisecond,
+			}))
+		})
+		It("should interpolate the latest frame", func() {
+			engine, recorder := stepped(CatchUpInterpolate)
+			engine.Step(850 * time.Millisecond)
+			Ω(completed(recorder)).Should(Equal([]float64{0, .85}))
+			Ω(recorder.Last().Index).Should(Equal(3))
+			Ω(recorder.Last().Dropped).Should(Equal(2))
+		})
+		It("should report late ticks", func(done Done) {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			clock := NewFakeClock(time.Now())
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Framerate = FPS(4)
+			engine.Clock = clock
+			engine.Start()
+			Eventually(recorder.Count).Should(Equal(1))
+			clock.Advance(250 * time.Millisecond)
+			Eventually(recorder.Count).Should(Equal(2))
+			Ω(recorder.Last().Jitter).Should(BeZero())
+			// a paused tween misses ticks it doesn't count as dropped
+			engine.Pause()
+			clock.Advance(500 * time.Millisecond)
+			engine.Resume()
+			clock.Advance(250 * time.Millisecond)
+			Eventually(recorder.Count).Should(Equal(3))
+			Ω(recorder.Last().Dropped).Should(Equal(0))
+			engine.Stop()
+			Eventually(recorder.Done).Should(Receive())
+			Ω(engine.Stats()).Should(Equal(Stats{Frames: 2}))
+			close(done)
+		}, 2)
+	})
+	Describe("Delta and Velocity", func() {
+		It("should report the time between frames", func() {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(time.Second, easing.Linear, recorder)
+			engine.Framerate = FPS(4)
+			engine.CatchUp = CatchUpEvery
+			engine.Step(250 * time.Millisecond)
+			engine.Step(500 * time.Millisecond)
+			engine.Seek(100 * time.Millisecond)
+			deltas := []time.Duration{}
+			for _, frame := range recorder.Frames {
+				deltas = append(deltas, frame.Delta)
+			}
+			Ω(deltas).Should(Equal([]time.Duration{
+				0,
+				250 * time.Millisecond,
+				250 * time.Millisecond,
+				250 * time.Millisecond,
+				-650 * time.Millisecond,
+			}))
+		})
+		It("should report the rate of change of the transition", func() {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			engine := NewEngine(500*time.Millisecond, easing.QuadIn, recorder)
+			engine.Framerate = FPS(4)
+			engine.Delay = 250 * time.Millisecond
+			engine.Repeat = 1
+			engine.Yoyo = true
+			engine.Render()
+			velocities := []float64{}
+			for _, frame := range recorder.Frames {
+				velocities = append(velocities, frame.Velocity)
+			}
+			// a quadratic ease in over half a second is 2t/0.5 per second
+			expected := []float64{0, 0, 2, -4, -2, 0}
+			Ω(velocities).Should(HaveLen(len(expected)))
+			for i, v := range velocities {
+				Ω(v).Should(BeNumerically("~", expected[i], 1e-5))
+			}
+		})
+	})
+	Describe("Settling", func() {
+		It("should run for as long as the spring takes to settle", func() {
+			recorder := &Recorder{Done: make(chan int, 1)}
+			spring := easing.SpringOptions{Stiffness: 100, Damping: 20, Mass: 1}
+			engine := NewSettlingEngine(spring, .01, recorder)
+			_, settle := spring.Settle(.01)
+			Ω(engine.Duration).Should(Equal(settle))
+			engine.Render()
+			Ω(recorder.Running).Should(Equal(settle))
+			Ω(recorder.Last().Transitioned).Should(Equal(1.))
+		})
+	})
+	Describe("Hooks", func() {
+		It("should call the hooks in order", func() {
+			log := &Log{}
+			engine := log.Engine(500 * time.Millisecond)
+			engine.Repeat = 1
+			engine.Render()
+			Ω(log.Events).Should(Equal([]string{
+				"Start", "OnStart",
+				"Update 0", "OnUpdate 0",
+				"OnRepeat 1", "Update 1", "OnUpdate 1",
+				"Update 1", "OnUpdate 1",
+				"End", "OnComplete",
+			}))
+		})
+		It("should call OnStop when stopped", func() {
+			log := &Log{}
+			engine := log.Engine(time.Second)
+			engine.Step(0)
+			engine.Stop()
+			Ω(log.Events[len(log.Events)-3:]).Should(Equal([]string{"OnUpdate 0", "End", "OnStop 2"}))
+		})
+		It("should call the hooks before Done is closed", func(done Done) {
+			log := &Log{}
+			clock := NewFakeClock(time.Now())
+			engine := log.Engine(time.Second)
+			engine.Clock = clock
+			ctx, cancel := context.WithCancel(context.Background())
+			engine.StartContext(ctx)
+			Eventually(log.Len).Should(Equal(4))
+			cancel()
+			Ω(engine.Wait()).Should(Equal(Cancelled))
+			Ω(log.Events[len(log.Events)-1]).Should(Equal("OnStop 3"))
 			close(done)
 		}, 2)
 	})
 })
+
+// Log is an Updater that logs the calls to itself and to the hooks of the
+// Engine it is given to.
+type Log struct {
+	sync.Mutex
+	Events []string
+}
+
+// Engine creates a 2fps Engine that logs its Updater calls and hooks.
+func (l *Log) Engine(duration time.Duration) *Engine {
+	engine := NewEngine(duration, easing.Linear, l)
+	engine.Framerate = FPS(2)
+	engine.OnStart = func(info StartInfo) { l.log("OnStart") }
+	engine.OnUpdate = func(frame Frame) { l.log(fmt.Sprint("OnUpdate ", frame.Iteration)) }
+	engine.OnRepeat = func(frame Frame) { l.log(fmt.Sprint("OnRepeat ", frame.Iteration)) }
+	engine.OnComplete = func() { l.log("OnComplete") }
+	engine.OnStop = func(result Result) { l.log(fmt.Sprint("OnStop ", result)) }
+	return engine
+}
+
+func (l *Log) Start(info StartInfo) { l.log("Start") }
+func (l *Log) Update(frame Frame)   { l.log(fmt.Sprint("Update ", frame.Iteration)) }
+func (l *Log) End()                 { l.log("End") }
+
+// Len returns the number of events logged so far.
+func (l *Log) Len() int {
+	l.Lock()
+	defer l.Unlock()
+	return len(l.Events)
+}
+
+func (l *Log) log(event string) {
+	l.Lock()
+	defer l.Unlock()
+	l.Events = append(l.Events, event)
+}
@@ -2,7 +2,6 @@ package updaters
 
 import (
 	"image/color"
-	"time"
 
 	"github.com/draoncc/tween"
 )
@@ -38,7 +37,7 @@ type Color struct {
 }
 
 // Start begins the color update.
-func (c *Color) Start(framerate, frames int, frameTime, runningTime time.Duration) {
+func (c *Color) Start(info tween.StartInfo) {
 	// Snapshot the color values - just in case someone tries to change it
 	c.from = c.From
 	c.to = c.To